@@ -0,0 +1,86 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	appos        = `(?i)\b(?:(?P<win>Win(?:32|64|dows)?)|(?P<mac>MacOSX|macOS|OSX)|(?P<linux>Linux)|(?P<android>Android)|(?P<ios>iOS))\b`
+	architecture = `(?i)\b(?:(?P<x64>x64|amd64|Win64)|(?P<x86>x86|i386|Win32)|(?P<arm64>ARM64|aarch64))\b`
+	apptokens    = `(?i)\b(?:(?P<multilingual>Multilingual|MULTi)|(?P<keygen>(?:Incl[. ])?(?:Keygen|KeyMaker))|(?P<portable>Portable)|(?P<retail>Retail))\b`
+	appversion   = `(?i)\bv([0-9]+)\b`
+
+	// normalized operating system and architecture names
+	appNameMap = map[string]string{
+		"WIN":     "Win",
+		"MAC":     "Mac",
+		"LINUX":   "Linux",
+		"ANDROID": "Android",
+		"IOS":     "iOS",
+		"X64":     "x64",
+		"X86":     "x86",
+		"ARM64":   "ARM64",
+	}
+)
+
+// recalculates the end of the title without the given parts
+func (r *Release) resetEnd(exclude ...string) {
+	r.end = 0
+	for name, p := range r.parts {
+		excluded := false
+		for _, e := range exclude {
+			excluded = excluded || name == e
+		}
+		if index := strings.Index(r.Input, p); !excluded && index > 0 && (r.end == 0 || index < r.end) {
+			r.end = index
+		}
+	}
+}
+
+// parses version, architecture, operating system and flags of application releases
+func (r *Release) parseApp(s string) {
+	// years in application names are part of the product name ex: Adobe.Photoshop.2020
+	r.Year = 0
+	r.resetEnd("year")
+
+	if r.Version == "" {
+		if m := regexp.MustCompile(appversion).FindString(s); m != "" {
+			r.Version = m
+			r.part("version", r.Input, m)
+		}
+	}
+	r.VersionInfo = parseVersion(r.Version)
+
+	re := regexp.MustCompile(appos)
+	if m := re.FindString(s); m != "" {
+		r.OS = appNameMap[getMatchedGroupName(re, m)]
+		// operating systems in front are the product name ex: Windows.10.x64, Linux.Mint.20
+		if strings.Index(r.Input, m) > 0 {
+			r.part("os", r.Input, m)
+		}
+	}
+
+	re = regexp.MustCompile(architecture)
+	if m := re.FindString(s); m != "" {
+		r.Architecture = appNameMap[getMatchedGroupName(re, m)]
+		r.part("architecture", r.Input, m)
+	}
+
+	re = regexp.MustCompile(apptokens)
+	for _, m := range re.FindAllString(s, -1) {
+		switch getMatchedGroupName(re, m) {
+		case "MULTILINGUAL":
+			r.Multilingual = true
+		case "KEYGEN":
+			r.Keygen = true
+		case "PORTABLE":
+			r.Portable = true
+		case "RETAIL":
+			r.Retail = true
+		}
+		r.part("apptokens", r.Input, m)
+	}
+
+	r.setTitle()
+}
@@ -0,0 +1,64 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	bookformat = `(?i)\b(EPUB|PDF|MOBI|AZW3|CBZ|CBR|M4B)\b`
+	booktokens = `(?i)\b(?:(?P<retail>RETAIL)|(?P<unabridged>UNABRIDGED)|(?P<abridged>ABRIDGED)|(?P<tag>eBook|AUDIOBOOK|ABOOK))\b`
+	issue      = `(?i)(?:#|\b(?:Issue|No|Nr)[. ]?)([0-9]{1,4})\b|\b(0[0-9]{2})\b`
+	issuedate  = `(?i)\b(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|June?|July?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)[. ]((?:19|20)[0-9]{2})\b`
+)
+
+// parses format, author, issue and flags of ebook, audiobook, comic and magazine releases
+func (r *Release) parseBook(s string) {
+	re := regexp.MustCompile(bookformat)
+	if m := re.FindString(s); m != "" {
+		r.BookFormat = strings.ToUpper(m)
+		r.part("bookformat", r.Input, m)
+	}
+
+	re = regexp.MustCompile(booktokens)
+	for _, m := range re.FindAllString(s, -1) {
+		switch getMatchedGroupName(re, m) {
+		case "RETAIL":
+			r.Retail = true
+		case "UNABRIDGED":
+			r.Unabridged = true
+		}
+		r.part("booktokens", r.Input, m)
+	}
+
+	if m := regexp.MustCompile(issuedate).FindStringSubmatch(s); m != nil {
+		if date, err := time.Parse("Jan 2006", m[1][:3]+" "+m[2]); err == nil {
+			r.IssueDate = date
+			r.Year = date.Year()
+		}
+		r.part("issuedate", r.Input, m[0])
+	}
+
+	// issue numbers are only used by periodicals, books often have numbers in their title
+	if r.Type == releaseTypeComic || r.Type == releaseTypeMagazine {
+		if m := regexp.MustCompile(issue).FindStringSubmatch(s); m != nil {
+			r.Issue = parseInt(m[1] + m[2])
+			r.part("issue", r.Input, m[0])
+		}
+	}
+
+	// books and audiobooks are named Author-Title
+	if r.Type == releaseTypeEbook || r.Type == releaseTypeAudiobook {
+		name := r.Input
+		if r.Group != "" {
+			name = strings.TrimSuffix(name, "-"+r.Group)
+		}
+		if fields := strings.SplitN(name, "-", 2); len(fields) == 2 && fields[0] != "" {
+			r.Author = cleanTitle(fields[0])
+			r.start = len(fields[0]) + 1
+		}
+	}
+
+	r.setTitle()
+}
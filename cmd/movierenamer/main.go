@@ -57,6 +57,9 @@ func main() {
 		fmt.Printf("{{.Input}}\t => \t %s\n", example.Input)
 		fmt.Printf("{{.Type}}\t => \t %s\n", example.Type)
 		fmt.Printf("{{.Title}}\t => \t %s\n", example.Title)
+		fmt.Printf("{{.SortTitle}}\t => \t %s\n", example.SortTitle())
+		fmt.Printf("{{.Slug}}\t => \t %s\n", example.Slug())
+		fmt.Printf("{{titlecase .Title}}\t => \t %s\n", releaseparser.TitleCase(example.Title))
 		fmt.Printf("{{.Year}}\t => \t %d\n", example.Year)
 		fmt.Printf("{{.Source}}\t => \t %s\n", example.Source)
 		fmt.Printf("{{.Resolution}}\t => \t %s\n", example.Resolution)
@@ -67,6 +70,9 @@ func main() {
 		fmt.Printf("{{.Season}}\t => \t %d\n", example.Season)
 		fmt.Printf("{{.Episode}}\t => \t %d\n", example.Episode)
 		fmt.Printf("{{.Uncut}}\t => \t %t\n", example.Uncut)
+		fmt.Printf("{{.Part}}\t => \t %d\n", example.Part)
+		fmt.Printf("{{.PartTotal}}\t => \t %d\n", example.PartTotal)
+		fmt.Printf("{{.Disc}}\t => \t %d\n", example.Disc)
 		fmt.Printf("\nfor a full list of available formatters see: https://godoc.org/github.com/cytec/releaseparser#Release\n")
 		os.Exit(1)
 	}
@@ -83,8 +89,9 @@ func main() {
 
 	mformatPointer := *mformat
 	tvformatPointer := *tvformat
-	mtemplate := template.Must(template.New("moviename").Parse(mformatPointer))
-	tvtemplate := template.Must(template.New("moviename").Parse(tvformatPointer))
+	funcs := template.FuncMap{"titlecase": releaseparser.TitleCase}
+	mtemplate := template.Must(template.New("moviename").Funcs(funcs).Parse(mformatPointer))
+	tvtemplate := template.Must(template.New("moviename").Funcs(funcs).Parse(tvformatPointer))
 
 	var mydirs = flag.Args()
 	// var currentDir = mydirs[0]
@@ -131,8 +138,8 @@ func main() {
 									ext := filepath.Ext(fn.Name())
 									fnameNew := strings.Trim(f.Name(), " ") + ext
 									fpathNew := filepath.Join(currentDir, f.Name(), fnameNew)
-									if isVideoExtension(ext) && !strings.Contains(strings.ToLower(fpath), "sample") {
-										// fmt.Printf("%s seems to be a non sample video file.", fn.Name())
+									if isVideoExtension(ext) && releaseparser.Classify(fpath) == releaseparser.FileMain {
+										// fmt.Printf("%s seems to be the main video file.", fn.Name())
 
 										fmt.Printf("rename '%s' => '%s'\n", chalk.Yellow.Color(fn.Name()), chalk.Green.Color(fnameNew))
 										if !*testrun {
@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/cytec/releaseparser"
 	"github.com/ttacon/chalk"
@@ -18,6 +19,7 @@ import (
 var help = flag.Bool("help", false, "echo usage command")
 var jsonout = flag.Bool("json", false, "dont rename just parse the releases and output JSON")
 var stdin = flag.Bool("stdin", false, "input from stdin most usefull with --json")
+var recursive = flag.Bool("recursive", false, "scan all files of nested directories, parent directories are used for missing release info")
 
 func printRelease(r *releaseparser.Release) {
 	v := reflect.ValueOf(r).Elem()
@@ -36,6 +38,8 @@ func printRelease(r *releaseparser.Release) {
 			fmt.Printf("\t%s:\t%s\n", name, value)
 		} else if fname == "int" && value != 0 {
 			fmt.Printf("\t%s:\t%d\n", name, value)
+		} else if date, ok := value.(time.Time); ok && !date.IsZero() {
+			fmt.Printf("\t%s:\t%s\n", name, date.Format("2006-01-02"))
 		}
 	}
 }
@@ -81,17 +85,33 @@ func main() {
 		if !*jsonout {
 			fmt.Printf(chalk.Bold.TextStyle("scanning directory '%s' for releases\n"), currentDir)
 		}
-		files, err := ioutil.ReadDir(currentDir)
-		if err != nil {
-			panic(err)
+		var paths []string
+		if *recursive {
+			err := filepath.Walk(currentDir, func(path string, f os.FileInfo, err error) error {
+				if err == nil && !f.IsDir() {
+					paths = append(paths, path)
+				}
+				return err
+			})
+			if err != nil {
+				panic(err)
+			}
+		} else {
+			files, err := ioutil.ReadDir(currentDir)
+			if err != nil {
+				panic(err)
+			}
+			for _, f := range files {
+				name := f.Name()
+				if !f.IsDir() {
+					name = strings.TrimSuffix(name, filepath.Ext(f.Name()))
+				}
+				paths = append(paths, filepath.Join(currentDir, name))
+			}
 		}
 
-		for _, f := range files {
-			name := f.Name()
-			if !f.IsDir() {
-				name = strings.TrimSuffix(name, filepath.Ext(f.Name()))
-			}
-			r := releaseparser.Parse(name)
+		for _, path := range paths {
+			r := releaseparser.ParsePath(path)
 			// append current parser result to output and go to next one
 			if *jsonout {
 				if r.Title != "" {
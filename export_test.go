@@ -0,0 +1,46 @@
+package releaseparser
+
+// SaveGroups returns a function which restores the group registry, tests use it with t.Cleanup
+func SaveGroups() func() {
+	groupMu.Lock()
+	defer groupMu.Unlock()
+
+	saved := make(map[string]*GroupInfo, len(groupRegistry))
+	for key, info := range groupRegistry {
+		saved[key] = info
+	}
+	return func() {
+		groupMu.Lock()
+		defer groupMu.Unlock()
+		groupRegistry = saved
+	}
+}
+
+// SaveClassifiers returns a function which restores the registered classifiers, tests use it with t.Cleanup
+func SaveClassifiers() func() {
+	classifierMu.Lock()
+	defer classifierMu.Unlock()
+
+	saved := append([]Classifier{}, classifiers...)
+	return func() {
+		classifierMu.Lock()
+		defer classifierMu.Unlock()
+		classifiers = saved
+	}
+}
+
+// SaveLeagues returns a function which restores the league registry, tests use it with t.Cleanup
+func SaveLeagues() func() {
+	leagueMu.Lock()
+	defer leagueMu.Unlock()
+
+	saved := make(map[string]*LeagueInfo, len(leagueRegistry))
+	for key, info := range leagueRegistry {
+		saved[key] = info
+	}
+	return func() {
+		leagueMu.Lock()
+		defer leagueMu.Unlock()
+		leagueRegistry = saved
+	}
+}
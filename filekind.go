@@ -0,0 +1,110 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// FileKind is the role of a single file inside a release
+type FileKind string
+
+// roles of files inside a release
+const (
+	FileMain            FileKind = "main"
+	FileSample          FileKind = "sample"
+	FileTrailer         FileKind = "trailer"
+	FileFeaturette      FileKind = "featurette"
+	FileDeletedScene    FileKind = "deleted_scene"
+	FileBehindTheScenes FileKind = "behind_the_scenes"
+	FileProof           FileKind = "proof"
+	FileSubtitle        FileKind = "subtitle"
+	FileNFO             FileKind = "nfo"
+	FileSFV             FileKind = "sfv"
+	FileArchivePart     FileKind = "archive_part"
+)
+
+var (
+	subtitleext = `(?i)\.(?:srt|sub|idx|ass|ssa|sup|vtt|smi)$`
+	// rar volumes in old and new style and split archives ex: .rar, .r00, .s01, .001, .part01.rar
+	archiveext = `(?i)\.(?:rar|r[0-9]{2}|s[0-9]{2}|[0-9]{3}|zip|7z)$`
+	// prefixes of scene samples and proofs which are followed by the group ex: sample-grp-movie.mkv, titles like
+	// Proof.of.Life.2000.mkv don't use hyphens
+	kindprefix = `^(sample|proof)-[a-z0-9]+-`
+	// disc and season directories inside a release ex: CD1, Disc 2, Season 01
+	partdir = `(?i)^(?:(?:cd|disc|dvd)[._ -]?[0-9]{1,2}|season[._ -]?[0-9]{1,2}|s[0-9]{1,2})$`
+
+	// names of extras, they are used as hyphenated suffix of the filename like scene and plex do ex: grp-movie-sample.mkv,
+	// Movie (2010)-trailer.mkv or as the name of the directory ex: Movie/Sample/grp-movie.mkv, Movie/Extras/Interview.mkv
+	// other separators belong to titles ex: The.Interview.mkv
+	fileKinds = []struct {
+		Kind  FileKind
+		Names string
+	}{
+		{FileSample, `samples?`},
+		{FileTrailer, `trailers?|teaser`},
+		{FileDeletedScene, `deleted(?:[._ -]?scenes?)?`},
+		{FileBehindTheScenes, `behind[._ -]?the[._ -]?scenes|making[._ -]?of`},
+		{FileFeaturette, `featurettes?|interviews?|extras?|bonus`},
+		{FileProof, `proofs?`},
+		{FileSubtitle, `subs|subtitles?|vobsubs?`},
+	}
+)
+
+// Classify returns the role of the file inside its release based on the extension, the filename and the
+// name of its directory ex: Movie.2010-GRP/Sample/grp-movie-sample.mkv is a sample, files without any hint are main files
+func Classify(path string) FileKind {
+	components := splitPath(path)
+	if len(components) == 0 {
+		return FileMain
+	}
+	file := components[len(components)-1]
+
+	switch {
+	case strings.HasSuffix(strings.ToLower(file), ".nfo"):
+		return FileNFO
+	case strings.HasSuffix(strings.ToLower(file), ".sfv"):
+		return FileSFV
+	case regexp.MustCompile(subtitleext).MatchString(file):
+		return FileSubtitle
+	}
+
+	name := file
+	if i := strings.LastIndex(name, "."); i > 0 {
+		name = name[:i]
+	}
+	if m := regexp.MustCompile(kindprefix).FindStringSubmatch(name); m != nil {
+		return FileKind(strings.ToLower(m[1]))
+	}
+	for _, k := range fileKinds {
+		if regexp.MustCompile(`(?i)[^._ -]-(?:` + k.Names + `)(?:[._ -]?[0-9]+)?$`).MatchString(name) {
+			return k.Kind
+		}
+	}
+
+	if len(components) > 1 {
+		if kind, ok := dirKind(components[len(components)-2]); ok {
+			return kind
+		}
+	}
+
+	if regexp.MustCompile(archiveext).MatchString(file) {
+		return FileArchivePart
+	}
+	return FileMain
+}
+
+// returns the kind of the files inside extras directories ex: Sample, Subs, Featurettes
+func dirKind(dir string) (FileKind, bool) {
+	for _, k := range fileKinds {
+		if regexp.MustCompile(`(?i)^(?:` + k.Names + `)$`).MatchString(dir) {
+			return k.Kind, true
+		}
+	}
+	return "", false
+}
+
+// true for directories inside a release which are no release themselves ex: Sample, Extras, CD1, Season 01
+func containerDir(dir string) bool {
+	_, ok := dirKind(dir)
+	return ok || regexp.MustCompile(partdir).MatchString(dir)
+}
@@ -0,0 +1,41 @@
+package releaseparser_test
+
+import (
+	"testing"
+
+	"github.com/cytec/releaseparser"
+)
+
+func TestClassify(t *testing.T) {
+	test := map[string]releaseparser.FileKind{
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle-1080p.mkv":              releaseparser.FileMain,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle-1080p-sample.mkv":       releaseparser.FileSample,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/Sample/grp-movietitle-1080p.mkv":       releaseparser.FileSample,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/sample-grp-movietitle.mkv":             releaseparser.FileSample,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/Proof/grp-movietitle.jpg":              releaseparser.FileProof,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle-proof.jpg":              releaseparser.FileProof,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/Subs/grp-movietitle.rar":               releaseparser.FileSubtitle,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/Subs/grp-movietitle.idx":               releaseparser.FileSubtitle,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle.nfo":                    releaseparser.FileNFO,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle.sfv":                    releaseparser.FileSFV,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle.r00":                    releaseparser.FileArchivePart,
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle.part01.rar":             releaseparser.FileArchivePart,
+		"Movie Title (2010)/Movie Title (2010)-trailer.mkv":                            releaseparser.FileTrailer,
+		"Movie Title (2010)/Movie Title (2010)-deleted.mkv":                            releaseparser.FileDeletedScene,
+		"Movie Title (2010)/Movie Title (2010)-behindthescenes.mkv":                    releaseparser.FileBehindTheScenes,
+		"Movie Title (2010)/Extras/Interview with the Director.mkv":                    releaseparser.FileFeaturette,
+		`D:\Movies\Movie Title (2010)\Deleted Scenes\Alternate Ending.mkv`:             releaseparser.FileDeletedScene,
+		"Movie Title (2010)/Movie Title (2010).en.srt":                                 releaseparser.FileSubtitle,
+		"Trailer.Park.Boys.S01E01.720p.WEB.x264-GRP/trailer.park.boys.s01e01.720p.mkv": releaseparser.FileMain,
+		"Movies/Proof.of.Life.2000.1080p.BluRay.x264-GRP.mkv":                          releaseparser.FileMain,
+		"Movies/Proof.2005.DVDRip.XviD-GRP.avi":                                        releaseparser.FileMain,
+		"Movies/The.Interview.mkv":                                                     releaseparser.FileMain,
+		"Movies/Sample.People.2000.DVDRip.XviD-GRP.avi":                                releaseparser.FileMain,
+	}
+
+	for path, want := range test {
+		if got := releaseparser.Classify(path); got != want {
+			t.Errorf("Classify failed for %s, got: %s, want: %s", path, got, want)
+		}
+	}
+}
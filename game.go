@@ -0,0 +1,109 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// VersionInfo holds the components of a version string ex: v2.6.9.68709
+type VersionInfo struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+	Patch int `json:"patch,omitempty"`
+	Build int `json:"build,omitempty"`
+}
+
+var (
+	platform   = `(?i)\b(?:(?P<nsw>NSW)|(?P<ps5>PS5)|(?P<ps4>PS4)|(?P<ps3>PS3)|(?P<xboxseries>XBOX[. ]?SERIES(?:[. ]?[XS])?|XSX)|(?P<xboxone>XBOX[. ]?ONE|XB1)|(?P<xbox360>XBOX360)|(?P<xbox>XBOX)|(?P<wiiu>WiiU)|(?P<wii>Wii)|(?P<psp>PSP)|(?P<psv>PSV)|(?P<nds>NDS)|(?P<n3ds>3DS)|(?P<win>Win(?:32|64|dows)?)|(?P<mac>MacOSX|macOS|OSX)|(?P<linux>Linux))\b`
+	gametokens = `(?i)\b(?:(?P<update>Update)|(?P<dlc>DLC)|(?P<cracked>Cracked|Crack(?:Fix)?)|(?P<eshop>eShop)|(?P<format>ISO|NSP|XCI)|MULTi(?P<multi>[0-9]{1,2}))\b`
+	gameregion = `(?i)\b(?:(?P<pal>PAL)|(?P<ntsc>NTSC(?:-[UJ])?)|(?P<usa>USA)|(?P<eur>EUR|EUROPE)|(?P<jpn>JPN|JAP))\b`
+	build      = `(?i)\bBuild[. ]?([0-9]+)\b`
+
+	// normalized platform names
+	platformMap = map[string]string{
+		"WIIU":  "WiiU",
+		"WII":   "Wii",
+		"N3DS":  "3DS",
+		"WIN":   "Win",
+		"MAC":   "Mac",
+		"LINUX": "Linux",
+	}
+)
+
+// parses version strings into their components, the fourth component is the build number
+func parseVersion(s string) *VersionInfo {
+	nums := regexp.MustCompile(`[0-9]+`).FindAllString(s, -1)
+	if len(nums) == 0 {
+		return nil
+	}
+
+	v := &VersionInfo{}
+	for i, n := range nums {
+		switch i {
+		case 0:
+			v.Major = parseInt(n)
+		case 1:
+			v.Minor = parseInt(n)
+		case 2:
+			v.Patch = parseInt(n)
+		case 3:
+			v.Build = parseInt(n)
+		}
+	}
+	return v
+}
+
+// parses platform, region, build and game specific tokens of console and pc releases
+func (r *Release) parseGame(s string) {
+	re := regexp.MustCompile(platform)
+	if m := re.FindString(s); m != "" {
+		r.Platform = getMatchedGroupName(re, m)
+		if p, ok := platformMap[r.Platform]; ok {
+			r.Platform = p
+		}
+		r.part("platform", r.Input, m)
+	}
+
+	re = regexp.MustCompile(gametokens)
+	for _, m := range re.FindAllString(s, -1) {
+		switch getMatchedGroupName(re, m) {
+		case "UPDATE":
+			r.Update = true
+		case "DLC":
+			r.DLC = true
+		case "CRACKED":
+			r.Cracked = true
+		case "ESHOP":
+			r.Eshop = true
+		case "FORMAT":
+			r.GameFormat = strings.ToUpper(m)
+		case "MULTI":
+			r.LanguageCount = parseInt(m)
+		}
+		r.part("gametokens", r.Input, m)
+	}
+
+	re = regexp.MustCompile(gameregion)
+	if m := re.FindString(s); m != "" {
+		r.Region = getMatchedGroupName(re, m)
+		r.part("gameregion", r.Input, m)
+		// PAL and NTSC are regions for games and not a DVD source
+		if r.Source == m {
+			r.Source = ""
+			r.SourceGroup = ""
+		}
+	}
+
+	if r.VersionInfo == nil {
+		r.VersionInfo = parseVersion(r.Version)
+	}
+	if m := regexp.MustCompile(build).FindStringSubmatch(s); m != nil {
+		if r.VersionInfo == nil {
+			r.VersionInfo = &VersionInfo{}
+		}
+		r.VersionInfo.Build = parseInt(m[1])
+		r.part("build", r.Input, m[0])
+	}
+
+	r.setTitle()
+}
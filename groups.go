@@ -0,0 +1,159 @@
+package releaseparser
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// GroupInfo holds the canonical name, known aliases and the origin of a release group
+type GroupInfo struct {
+	Name    string   `json:"name"`              // canonical name of the group ex: YTS
+	Aliases []string `json:"aliases,omitempty"` // alternative spellings of the group ex: YIFY, YTS.MX
+	Scene   bool     `json:"scene"`             // true for scene groups, false for P2P groups
+	Type    string   `json:"type,omitempty"`    // release type if the group only releases one type ex: pc
+}
+
+var (
+	// tracker tags and obfuscation suffixes that get appended to the group ex: GRP[rarbg], GRP-Obfuscated
+	groupsuffix = `(?i)(?:\s*\[(?:rarbg|eztv|ettv|rartv|TGx|PublicHD)\]|-(?:Obfuscated|Scrambled|RP|postbot|xpost|AsRequested|BUYMORE|Chamele0n))+(\.(?:mkv|avi|mp4|m4v))?$`
+
+	// trailing site or group tags of p2p releases ex: Movie Title (2010) [1080p] [YTS.MX].mp4, Movie.x264-[YTS.AM].mp4
+	sitetag = `(?:\s+|-)[\[(]([^\[\]()]+)[\])](\.(?:mkv|avi|mp4|m4v))?$`
+	// websites need a www prefix or a common top level domain, dotted tags like [DTS-HD.MA] or [Dual.Audio] are no websites
+	domain = `(?i)^(?:www\.[a-z0-9-]+(?:\.[a-z0-9-]+)+|[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|info|biz|to|io|me|cc|tv|ws|xyz|club|site|online|am|ag|lt|mx|is|se|nu|li|ch|de|nl|eu|ru|uk|fr|it|es|pl|cz|ro|ca|us))$`
+
+	// holds all known groups, keyed by the lowercase name and aliases
+	groupRegistry = map[string]*GroupInfo{}
+	groupMu       sync.RWMutex
+
+	defaultGroups = []GroupInfo{
+		{Name: "CODEX", Scene: true, Type: releaseTypePC},
+		{Name: "DARKSiDERS", Scene: true, Type: releaseTypePC},
+		{Name: "PLAZA", Scene: true, Type: releaseTypePC},
+		{Name: "RAZOR1911", Aliases: []string{"RAZOR", "Razor1911"}, Scene: true, Type: releaseTypePC},
+		{Name: "SiMPLEX", Scene: true, Type: releaseTypePC},
+		{Name: "HOODLUM", Scene: true, Type: releaseTypePC},
+		{Name: "SKIDROW", Scene: true, Type: releaseTypePC},
+		{Name: "ALiAS", Scene: true, Type: releaseTypePC},
+		{Name: "DIMENSION", Scene: true},
+		{Name: "LOL", Scene: true},
+		{Name: "KILLERS", Scene: true},
+		{Name: "SPARKS", Scene: true},
+		{Name: "AMIABLE", Scene: true},
+		{Name: "SORNY", Scene: true},
+		{Name: "TVS", Scene: true},
+		{Name: "RARBG", Aliases: []string{"RARBG.to", "rartv"}},
+		{Name: "YTS", Aliases: []string{"YIFY", "YTS.MX", "YTS.AM", "YTS.LT", "YTS.AG"}},
+		{Name: "ETRG", Aliases: []string{"ETHD"}},
+		{Name: "ettv"},
+		{Name: "eztv", Aliases: []string{"EZTV.re"}},
+		{Name: "NTb"},
+		{Name: "FLUX"},
+		{Name: "EVO"},
+		{Name: "PSA"},
+		{Name: "QxR"},
+		{Name: "Tigole"},
+	}
+)
+
+func init() {
+	for _, g := range defaultGroups {
+		RegisterGroup(g)
+	}
+}
+
+// RegisterGroup adds a group to the registry or replaces an existing one with the same name or alias
+func RegisterGroup(g GroupInfo) {
+	groupMu.Lock()
+	defer groupMu.Unlock()
+
+	info := g
+	groupRegistry[strings.ToLower(info.Name)] = &info
+	for _, alias := range info.Aliases {
+		groupRegistry[strings.ToLower(alias)] = &info
+	}
+}
+
+// LoadGroups registers all groups of a JSON array of GroupInfo objects ex:
+// [{"name": "CODEX", "aliases": ["C0DEX"], "scene": true, "type": "pc"}]
+func LoadGroups(r io.Reader) error {
+	groups := []GroupInfo{}
+	if err := json.NewDecoder(r).Decode(&groups); err != nil {
+		return err
+	}
+	for _, g := range groups {
+		RegisterGroup(g)
+	}
+	return nil
+}
+
+// LoadGroupsFile registers all groups of the given JSON file, see LoadGroups
+func LoadGroupsFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadGroups(f)
+}
+
+// LookupGroup returns the registered group info for the given group name or alias
+func LookupGroup(name string) (GroupInfo, bool) {
+	groupMu.RLock()
+	defer groupMu.RUnlock()
+
+	info, ok := groupRegistry[strings.ToLower(cleanGroup(name))]
+	if !ok {
+		return GroupInfo{}, false
+	}
+	return *info, true
+}
+
+// CanonicalGroup returns the canonical name of a group, unknown groups are returned cleaned but unchanged
+func CanonicalGroup(name string) string {
+	if info, ok := LookupGroup(name); ok {
+		return info.Name
+	}
+	return cleanGroup(name)
+}
+
+// removes tracker tags, obfuscation suffixes and file extensions from the group name
+func cleanGroup(name string) string {
+	name = regexp.MustCompile(groupsuffix).ReplaceAllString(name, "")
+	name = regexp.MustCompile(container).ReplaceAllString(name, "")
+	return strings.Trim(name, " .-")
+}
+
+// true if the tag holds quality information instead of a site ex: [x264.AAC], [WEB-DL.1080p]
+func isQualityTag(tag string) bool {
+	for _, str := range []string{codec, audio, source, resolution, language} {
+		if regexp.MustCompile(str).MatchString(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// parses trailing tags of known groups and websites and returns the string with the tag removed
+// ex: Movie Title (2010) [1080p] [YTS.MX].mp4 => YTS.MX, Movie Title (2010) [www.site.com] => www.site.com
+func (r *Release) parseSiteTag(s string) string {
+	re := regexp.MustCompile(sitetag)
+	m := re.FindStringSubmatch(s)
+	if m == nil {
+		return s
+	}
+	tag := strings.Trim(m[1], " ")
+	if _, ok := LookupGroup(tag); ok {
+		r.Group = tag
+	} else if regexp.MustCompile(domain).MatchString(tag) && !isQualityTag(tag) {
+		r.Website = tag
+	} else {
+		return s
+	}
+	r.part("sitetag", r.Input, strings.TrimSuffix(m[0], m[2]))
+	return re.ReplaceAllString(s, "$2")
+}
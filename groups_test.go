@@ -0,0 +1,85 @@
+package releaseparser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cytec/releaseparser"
+)
+
+func TestLookupGroup(t *testing.T) {
+	test := map[string]releaseparser.GroupInfo{
+		"yify":           {Name: "YTS", Scene: false},
+		"YTS.MX":         {Name: "YTS", Scene: false},
+		"dimension":      {Name: "DIMENSION", Scene: true},
+		"Razor1911":      {Name: "RAZOR1911", Scene: true},
+		"RARBG[rarbg]":   {Name: "RARBG", Scene: false},
+		"LOL-Obfuscated": {Name: "LOL", Scene: true},
+	}
+
+	for name, want := range test {
+		info, ok := releaseparser.LookupGroup(name)
+		if !ok {
+			t.Errorf("LookupGroup failed for %s, group not found", name)
+			continue
+		}
+		if info.Name != want.Name {
+			t.Errorf("Name failed for %s, got: %s, want: %s", name, info.Name, want.Name)
+		}
+		if info.Scene != want.Scene {
+			t.Errorf("Scene failed for %s, got: %t, want: %t", name, info.Scene, want.Scene)
+		}
+	}
+
+	if _, ok := releaseparser.LookupGroup("SomeUnknownGroup"); ok {
+		t.Errorf("LookupGroup should not find unknown groups")
+	}
+}
+
+func TestRegisterGroup(t *testing.T) {
+	t.Cleanup(releaseparser.SaveGroups())
+	releaseparser.RegisterGroup(releaseparser.GroupInfo{Name: "MyGroup", Aliases: []string{"MyGrp"}, Scene: true})
+
+	if got := releaseparser.CanonicalGroup("mygrp"); got != "MyGroup" {
+		t.Errorf("CanonicalGroup failed, got: %s, want: MyGroup", got)
+	}
+	if got := releaseparser.CanonicalGroup("Unknown.mkv"); got != "Unknown" {
+		t.Errorf("CanonicalGroup failed, got: %s, want: Unknown", got)
+	}
+}
+
+func TestLoadGroups(t *testing.T) {
+	t.Cleanup(releaseparser.SaveGroups())
+	groups := `[{"name": "TiNYiSO", "aliases": ["TINY"], "scene": true, "type": "pc"}]`
+	if err := releaseparser.LoadGroups(strings.NewReader(groups)); err != nil {
+		t.Fatalf("LoadGroups failed: %s", err)
+	}
+
+	r := releaseparser.Parse("Some.Game-TINY")
+	if r.GroupCanonical != "TiNYiSO" {
+		t.Errorf("GroupCanonical failed, got: %s, want: TiNYiSO", r.GroupCanonical)
+	}
+	if r.Type != "pc" {
+		t.Errorf("Type failed, got: %s, want: pc", r.Type)
+	}
+
+	if err := releaseparser.LoadGroups(strings.NewReader("no json")); err == nil {
+		t.Errorf("LoadGroups should fail for invalid JSON")
+	}
+}
+
+func TestRegisterGroupConcurrent(t *testing.T) {
+	t.Cleanup(releaseparser.SaveGroups())
+
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 50; i++ {
+			releaseparser.RegisterGroup(releaseparser.GroupInfo{Name: "RaceGroup"})
+		}
+		done <- true
+	}()
+	for i := 0; i < 50; i++ {
+		releaseparser.Parse("Some.Movie.2019.1080p.BluRay.x264-RaceGroup")
+	}
+	<-done
+}
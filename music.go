@@ -0,0 +1,85 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	musicyear    = `^(?:19|20)[0-9]{2}$`
+	musiccatalog = `^\(([A-Za-z]+[._]?[0-9]+[A-Za-z0-9]*)\)$`
+	musicformat  = `(?i)^(FLAC|MP3|AAC|ALAC|WAV|OGG)$`
+	musicbitrate = `(?i)^(?:(320|256|192|128|V0|V2)|([0-9]{2})BIT|([0-9]{2,3}(?:\.[0-9])?)KHZ)$`
+	// bit depths, sample rates and vbr presets of music releases ex: 24BIT-96KHZ, V0
+	musicbitdepth = `(?i)\b(?:(?:16|24|32)BIT|[0-9]{2,3}(?:\.[0-9])?KHZ|V0|V2)\b`
+	musicmedia    = `(?i)^(?:[0-9]*(CD[MS]?|CDR)|(WEB|VINYL|VLS|LP|SAT|DAB|FM|DVD|BD|TAPE|MC))$`
+
+	// normalized media names
+	musicMediaMap = map[string]string{
+		"VLS": "VINYL",
+		"LP":  "VINYL",
+		"MC":  "TAPE",
+		"CDR": "CD",
+	}
+)
+
+// parses the dash separated fields of music releases ex: Artist_Name-Album_Title-(CAT123)-WEB-2020-GRP
+func (r *Release) parseMusic() {
+	name := regexp.MustCompile(password).ReplaceAllString(r.Input, "")
+	fields := strings.Split(name, "-")
+	if len(fields) < 3 {
+		return
+	}
+	// the last field is the group
+	fields = fields[:len(fields)-1]
+
+	yearregex := regexp.MustCompile(musicyear)
+	catalogregex := regexp.MustCompile(musiccatalog)
+	formatregex := regexp.MustCompile(musicformat)
+	bitrateregex := regexp.MustCompile(musicbitrate)
+	mediaregex := regexp.MustCompile(musicmedia)
+
+	bits, rate := "", ""
+	tags := len(fields)
+	for i := len(fields) - 1; i > 0; i-- {
+		field := strings.Trim(fields[i], " _.")
+		if yearregex.MatchString(field) {
+			r.Year = parseInt(field)
+		} else if m := catalogregex.FindStringSubmatch(field); m != nil {
+			r.CatalogNumber = strings.Replace(m[1], "_", " ", -1)
+		} else if formatregex.MatchString(field) {
+			r.AudioFormat = strings.ToUpper(field)
+		} else if m := bitrateregex.FindStringSubmatch(field); m != nil {
+			if m[1] != "" {
+				r.Bitrate = strings.ToUpper(m[1])
+			} else if m[2] != "" {
+				bits = m[2] + "bit"
+			} else {
+				rate = m[3] + "kHz"
+			}
+		} else if m := mediaregex.FindStringSubmatch(field); m != nil {
+			r.Media = strings.ToUpper(m[1] + m[2])
+			if media, ok := musicMediaMap[r.Media]; ok {
+				r.Media = media
+			}
+		} else if regexp.MustCompile(language).MatchString(field) || regexp.MustCompile(`(?i)^(?:REPACK|PROPER|BOOTLEG|PROMO|READ_?NFO|RETAIL)$`).MatchString(field) {
+			// other known tags between album and group
+		} else {
+			break
+		}
+		tags = i
+	}
+
+	// hi-res releases have the bit depth and sample rate in separate fields ex: 24BIT-96KHZ
+	if bits != "" || rate != "" {
+		r.Bitrate = strings.Trim(bits+"/"+rate, "/")
+	}
+
+	r.Artist = cleanTitle(fields[0])
+	album := []string{}
+	for _, f := range fields[1:tags] {
+		album = append(album, cleanTitle(f))
+	}
+	r.Album = strings.Join(album, " - ")
+	r.Title = r.Album
+}
@@ -0,0 +1,61 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	mvsource    = `(?i)\b(?:(?P<bluray>MBluRay)|(?P<dvdr>MDVDR)|(?P<dvb>DVB[SCT]?))\b`
+	performance = `(?i)\bLive[. ]At\b`
+	// performances are only evidence with an artist in front ex: Artist.Live.At.Wembley but not We.Live.in.Time
+	concert     = `(?i)[a-z0-9][. ]Live[. ]At\b`
+	mvdashyear  = `-(?:19|20)[0-9]{2}-[^-]+$`
+	mvtags      = `(?i)^(?:PROPER|REPACK|INTERNAL|READ_?NFO|WEB|DDC|PAL|NTSC)$`
+	mvstructure = `(?i)-(?:[xh]26[45]|XviD|DivX|HDTV|DVDRip|MBluRay|MDVDR|DVB[SC])-(?:[^-]+-)*(?:19|20)[0-9]{2}-[^-]+$`
+)
+
+// parses artist and performance of concerts and music videos
+// ex: Artist.Live.At.Wembley.2019.1080p.MBluRay.x264-GRP, Artist-Song_Title-DVBS-x264-2020-GRP
+func (r *Release) parseMusicVideo() {
+	re := regexp.MustCompile(mvsource)
+	if m := re.FindString(r.Input); m != "" {
+		r.Source = m
+		r.SourceGroup = getMatchedGroupName(re, m)
+		r.part("mvsource", r.Input, m)
+	}
+
+	name := regexp.MustCompile(password).ReplaceAllString(r.Input, "")
+	if regexp.MustCompile(mvdashyear).MatchString(name) {
+		// scene music videos are dash separated like music releases, the last field is the group
+		fields := strings.Split(name, "-")
+		fields = fields[:len(fields)-1]
+
+		tags := len(fields)
+		for i := len(fields) - 1; i > 0; i-- {
+			field := strings.Trim(fields[i], " _.")
+			if regexp.MustCompile(musicyear).MatchString(field) {
+				r.Year = parseInt(field)
+			} else if !regexp.MustCompile(`^(?:` + codec + `|` + source + `|` + resolution + `|` + mvsource + `|` + language + `|` + mvtags + `)$`).MatchString(field) {
+				break
+			}
+			tags = i
+		}
+
+		r.Artist = strings.Trim(strings.Replace(fields[0], "_", " ", -1), " ")
+		performance := []string{}
+		for _, f := range fields[1:tags] {
+			performance = append(performance, strings.Trim(strings.Replace(f, "_", " ", -1), " "))
+		}
+		r.Performance = strings.Join(performance, " - ")
+		r.Title = r.Performance
+		return
+	}
+
+	r.setTitle()
+	if loc := regexp.MustCompile(performance).FindStringIndex(r.Title); loc != nil && loc[0] > 0 {
+		r.Artist = strings.Trim(r.Title[:loc[0]], " ")
+		r.Performance = r.Title[loc[0]:]
+		r.Title = r.Performance
+	}
+}
@@ -0,0 +1,81 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// hex hashes and uuids ex: a8f3c9e1b2d4, 0f8fad5b-d9cb-469f-a165-70867728950e
+	hashname = `(?i)^(?:[0-9a-f]{8,}|[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12})$`
+	// long random strings of mixed case letters and digits ex: Xk3j9LmQ2pR7sT1v
+	randomname = `^[A-Za-z0-9]{16,}$`
+	// file extensions which are removed before the check
+	fileext = `(?i)\.(?:mkv|avi|mp4|m4v|nfo|srt|sub|idx|ass|ssa|sfv|txt|nzb|par2)$`
+)
+
+// true if the release name is a hash, a long random string or consists of random or short lowercase tokens
+// without any recognized parts ex: a8f3c9e1b2d4.mkv, abc.xyz.mkv, titles like up.mkv or Shrek2.mkv are no random names
+func (r *Release) obfuscated() bool {
+	name := regexp.MustCompile(fileext).ReplaceAllString(r.Input, "")
+	if name == "" {
+		return false
+	}
+	if regexp.MustCompile(hashname).MatchString(name) {
+		return hasLetter(name) && strings.ContainsAny(name, "0123456789")
+	}
+	if regexp.MustCompile(randomname).MatchString(name) {
+		return name != strings.ToLower(name) && name != strings.ToUpper(name) && strings.ContainsAny(name, "0123456789")
+	}
+
+	// real release names have at least some recognized parts
+	if r.Season > 0 || r.Episode > 0 || r.Year > 0 || !r.AirDate.IsZero() || r.Disc > 0 || r.Part > 0 || r.Resolution != "" ||
+		r.Source != "" || r.Codec != "" || r.Group != "" {
+		return false
+	}
+	tokens := strings.FieldsFunc(name, func(c rune) bool {
+		return c == '.' || c == '_' || c == '-' || c == ' '
+	})
+	random, short := 0, 0
+	for _, t := range tokens {
+		if randomToken(t) {
+			random++
+		}
+		if len(t) <= 3 && t == strings.ToLower(t) && !strings.ContainsAny(t, "0123456789") && !smallWords[t] {
+			short++
+		}
+	}
+	// a single short word or articles and prepositions are titles ex: up.mkv, her.mkv, the.fly.mkv
+	return len(tokens) > 0 && (random*2 >= len(tokens) || len(tokens) > 1 && short == len(tokens))
+}
+
+// true for tokens which can't be words ex: xkcd, k3j9, qwrtzpk, acronyms like BBC are words
+func randomToken(t string) bool {
+	if !hasLetter(t) || len(t) <= 5 && t == strings.ToUpper(t) {
+		return false
+	}
+	vowels, run, maxrun := 0, 0, 0
+	for _, c := range strings.ToLower(t) {
+		switch {
+		case c > unicode.MaxASCII:
+			// non latin words are no random strings
+			return false
+		case strings.ContainsRune("aeiouy", c):
+			vowels++
+			run = 0
+		case unicode.IsDigit(c):
+			run = 0
+		default:
+			run++
+		}
+		if run > maxrun {
+			maxrun = run
+		}
+	}
+	return vowels == 0 || maxrun >= 6
+}
+
+func hasLetter(s string) bool {
+	return strings.IndexFunc(s, unicode.IsLetter) >= 0
+}
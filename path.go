@@ -0,0 +1,151 @@
+package releaseparser
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var (
+	// extensions of files next to the release which don't tell anything about it
+	sidecarext = `(?i)\.(?:nfo|srt|sub|idx|ass|ssa|sfv|txt|jpg|png|nzb|par2)$`
+
+	// fields which are taken from the file, they are more specific than the directories
+	fileFields = []string{"Season", "Seasons", "Episode", "EpisodeEnd", "Episodes", "EpisodeTitle", "AirDate", "Part", "PartTotal", "Disc", "Container", "CRC32"}
+	// fields which are taken from the nearest release directory, files are often renamed or abbreviated, collections
+	// like Marvel.Collection/Iron.Man.2008.mkv have no release information and only give a missing title
+	dirFields = []string{"Title", "AlternateTitles", "Country", "Year", "Group", "GroupCanonical", "Resolution", "Source", "SourceGroup",
+		"Codec", "CodecGroup", "Audio", "AudioGroup", "Language", "DubType"}
+)
+
+// ParsePath parses every component of the path and merges the results, the file gives the episode information
+// while the parent directories give title, quality and group ex: Show.Name.S01.1080p.BluRay-GRP/abc-s01e02-720p.mkv
+// directories are used up to the first one without release information, e.g. Movies or Downloads, directories
+// inside a release like Sample, Subs or CD1 are skipped
+func ParsePath(path string) *Release {
+	components := splitPath(path)
+	if len(components) == 0 {
+		return Parse(path)
+	}
+
+	file := regexp.MustCompile(sidecarext).ReplaceAllString(components[len(components)-1], "")
+	r := Parse(file)
+	taken := map[string]bool{}
+	for _, name := range fileFields {
+		taken[name] = !reflect.ValueOf(r).Elem().FieldByName(name).IsZero()
+	}
+	// files inside a season pack are no season packs themselves ex: Show.S01-GRP/Extras/Interview.mkv
+	taken["IsSeasonPack"] = true
+
+	// obfuscated files take the release info of a companion nfo before the directories
+	// ex: Movie.Title.2010.1080p.BluRay-GRP/{a8f3c9e1b2d4.mkv,Movie.Title.2010.1080p.BluRay-GRP.nfo}
+	if r.IsObfuscated {
+		if nfo := companionNFO(path); nfo != nil {
+			r.merge(nfo, taken)
+		}
+	}
+
+	for i := len(components) - 2; i >= 0; i-- {
+		dir := Parse(components[i])
+		if containerDir(components[i]) {
+			// only the season or disc of container directories is used ex: Movie.2010-GRP/CD1/grp-movie.avi
+			dir.Title = ""
+			r.merge(dir, taken)
+			continue
+		}
+		if !dir.hasReleaseInfo() {
+			// plain directories only give the title if nothing else did ex: Show Name/Season 01/e01.mkv
+			if r.Title == "" {
+				r.Title = dir.Title
+			}
+			if r.Title == "" {
+				r.Title = cleanTitle(components[i])
+			}
+			break
+		}
+		r.merge(dir, taken)
+	}
+
+	// episodes of season packs are no season packs
+	if r.Episode > 0 || !r.AirDate.IsZero() {
+		r.IsSeasonPack = false
+		// the episode belongs to a single season ex: Show.S01-S03.1080p-GRP/Show.S02E05.mkv
+		r.SeasonEnd = 0
+		if r.Type != releaseTypeAnime {
+			r.Type = releaseTypeTV
+		}
+	}
+	if r.Season > 0 && len(r.Seasons) == 0 {
+		r.Seasons = r.seasonList()
+	}
+	r.Input = path
+	return r
+}
+
+// splits unix and windows paths into their components
+func splitPath(path string) []string {
+	return strings.FieldsFunc(path, func(c rune) bool {
+		return c == '/' || c == '\\'
+	})
+}
+
+// parses the first nfo next to the file which is no obfuscated name itself, the directory is read directly
+// because names of p2p releases contain glob patterns ex: Movie (2010) [1080p]/a8f3c9e1b2d4.mkv
+func companionNFO(path string) *Release {
+	i := strings.LastIndexAny(path, `/\`)
+	if i < 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(path[:i+1])
+	if err != nil {
+		return nil
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".nfo") {
+			continue
+		}
+		nfo := Parse(regexp.MustCompile(sidecarext).ReplaceAllString(entry.Name(), ""))
+		if !nfo.IsObfuscated && nfo.hasReleaseInfo() {
+			return nfo
+		}
+	}
+	return nil
+}
+
+// true if the release has more than a title
+func (r *Release) hasReleaseInfo() bool {
+	return r.Season > 0 || r.Episode > 0 || r.Year > 0 || !r.AirDate.IsZero() || r.Resolution != "" || r.Source != "" ||
+		r.Codec != "" || r.Group != "" || r.IsCompleteSeries || r.IsSpecial
+}
+
+// merges the parsed directory into the release, directory fields override the file and all other empty
+// fields are filled, fields in taken were set by a nearer layer and are kept
+func (r *Release) merge(dir *Release, taken map[string]bool) {
+	dst := reflect.ValueOf(r).Elem()
+	src := reflect.ValueOf(dir).Elem()
+
+	for _, name := range dirFields {
+		if value := src.FieldByName(name); !taken[name] && !value.IsZero() {
+			dst.FieldByName(name).Set(value)
+			taken[name] = true
+		}
+	}
+
+	// the type of the directory wins over the movie fallback of files like e01.mkv
+	if r.Type == releaseTypeMovie && dir.Type != releaseTypeMovie {
+		r.Type = dir.Type
+		r.TypeScores = dir.TypeScores
+		taken["Type"] = true
+	}
+
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if field.PkgPath != "" || taken[field.Name] {
+			continue
+		}
+		if value := src.Field(i); dst.Field(i).IsZero() && !value.IsZero() {
+			dst.Field(i).Set(value)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package releaseparser_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cytec/releaseparser"
+)
+
+func TestParsePath(t *testing.T) {
+	test := map[string]*releaseparser.Release{
+		"Show.Name.S01.1080p.BluRay-GRP/abc-s01e02-720p.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "Show Name",
+			Season:     1,
+			Episode:    2,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"The.Office.US.S01.720p.HDTV.x264-GRP/the.office.s01e01.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "The Office",
+			Season:     1,
+			Episode:    1,
+			Resolution: "720p",
+			Source:     "HDTV",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"Show.Name.S01.1080p.BluRay-GRP/e01.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "Show Name",
+			Season:     1,
+			Episode:    1,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"/data/tv/Show.Name.S01.1080p.BluRay.x264-GRP/abc-s01e02-720p.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "Show Name",
+			Season:     1,
+			Episode:    2,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"Show Name/Season 01/e01.mkv": &releaseparser.Release{
+			Type:      "tvshow",
+			Title:     "Show Name",
+			Season:    1,
+			Episode:   1,
+			Container: "mkv",
+		},
+		"Show Name/Season 02/Show.Name.S02E03.Title.720p.HDTV.x264-GRP.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "Show Name",
+			Season:     2,
+			Episode:    3,
+			Resolution: "720p",
+			Source:     "HDTV",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"/data/Movies/Movie.Title.2010.1080p.BluRay.x264-GRP.mkv": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/grp-movietitle-cd2.avi": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Disc:       2,
+			Container:  "avi",
+			Group:      "GRP",
+		},
+		`D:\Movies\Movie.Title.2010.1080p.BluRay.x264-GRP\Movie.Title.2010.1080p.BluRay.x264-GRP.nfo`: &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Group:      "GRP",
+		},
+		"/data/Movies/Movie.Title.2010.1080p.BluRay.x264-GRP/a8f3c9e1b2d4f6a7.mkv": &releaseparser.Release{
+			Type:         "movie",
+			Title:        "Movie Title",
+			Year:         2010,
+			Resolution:   "1080p",
+			Source:       "BluRay",
+			Codec:        "x264",
+			Container:    "mkv",
+			Group:        "GRP",
+			IsObfuscated: true,
+		},
+		"Movie.Title.2010.DVDRip.XviD-GRP/CD1/grp-movietitle.avi": &releaseparser.Release{
+			Type:      "movie",
+			Title:     "Movie Title",
+			Year:      2010,
+			Source:    "DVDRip",
+			Codec:     "XviD",
+			Disc:      1,
+			Container: "avi",
+			Group:     "GRP",
+		},
+		"a8f3c9e1b2d4.mkv": &releaseparser.Release{
+			Type:         "movie",
+			Container:    "mkv",
+			IsObfuscated: true,
+		},
+		"/data/Movies/Marvel.Collection/Iron.Man.2008.1080p.BluRay.x264-GRP.mkv": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Iron Man",
+			Year:       2008,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"Movie.Title.2010.1080p.BluRay.x264-GRP/Sample/grp-movietitle-sample.mkv": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"Show.Name.S01.1080p.BluRay.x264-GRP/Extras/Interview.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "Show Name",
+			Season:     1,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+		"Show.Name.S01-S03.1080p.BluRay.x264-GRP/Show.Name.S02E05.1080p.BluRay.x264-GRP.mkv": &releaseparser.Release{
+			Type:       "tvshow",
+			Title:      "Show Name",
+			Season:     2,
+			Episode:    5,
+			Resolution: "1080p",
+			Source:     "BluRay",
+			Codec:      "x264",
+			Container:  "mkv",
+			Group:      "GRP",
+		},
+	}
+
+	for path, want := range test {
+		parsed := releaseparser.ParsePath(path)
+		t.Logf("Running tests for %s\n", path)
+
+		if parsed.Input != path {
+			t.Errorf("Input failed, got: %s, want: %s", parsed.Input, path)
+		}
+		if want.Type != parsed.Type {
+			t.Errorf("Type failed, got: %s, want: %s", parsed.Type, want.Type)
+		}
+		if want.Title != parsed.Title {
+			t.Errorf("Title failed, got: %s, want: %s", parsed.Title, want.Title)
+		}
+		if want.Year != parsed.Year {
+			t.Errorf("Year failed, got: %d, want: %d", parsed.Year, want.Year)
+		}
+		if want.Season != parsed.Season {
+			t.Errorf("Season failed, got: %d, want: %d", parsed.Season, want.Season)
+		}
+		if want.SeasonEnd != parsed.SeasonEnd {
+			t.Errorf("SeasonEnd failed, got: %d, want: %d", parsed.SeasonEnd, want.SeasonEnd)
+		}
+		if want.Episode != parsed.Episode {
+			t.Errorf("Episode failed, got: %d, want: %d", parsed.Episode, want.Episode)
+		}
+		if want.Resolution != parsed.Resolution {
+			t.Errorf("Resolution failed, got: %s, want: %s", parsed.Resolution, want.Resolution)
+		}
+		if want.Source != parsed.Source {
+			t.Errorf("Source failed, got: %s, want: %s", parsed.Source, want.Source)
+		}
+		if want.Codec != parsed.Codec {
+			t.Errorf("Codec failed, got: %s, want: %s", parsed.Codec, want.Codec)
+		}
+		if want.Disc != parsed.Disc {
+			t.Errorf("Disc failed, got: %d, want: %d", parsed.Disc, want.Disc)
+		}
+		if want.Container != parsed.Container {
+			t.Errorf("Container failed, got: %s, want: %s", parsed.Container, want.Container)
+		}
+		if want.Group != parsed.Group {
+			t.Errorf("Group failed, got: %s, want: %s", parsed.Group, want.Group)
+		}
+		if want.IsObfuscated != parsed.IsObfuscated {
+			t.Errorf("IsObfuscated failed, got: %t, want: %t", parsed.IsObfuscated, want.IsObfuscated)
+		}
+		if parsed.IsSeasonPack {
+			t.Errorf("IsSeasonPack failed, got: %t, want: false", parsed.IsSeasonPack)
+		}
+	}
+}
+
+func TestParsePathNFO(t *testing.T) {
+	// brackets of p2p names are no glob patterns
+	dir := filepath.Join(t.TempDir(), "Movie Title (2010) [1080p]")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a8f3c9e1b2d4f6a7.mkv", "Movie.Title.2010.1080p.BluRay.x264-GRP.nfo"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	parsed := releaseparser.ParsePath(filepath.Join(dir, "a8f3c9e1b2d4f6a7.mkv"))
+	if !parsed.IsObfuscated {
+		t.Errorf("IsObfuscated failed, got: %t, want: true", parsed.IsObfuscated)
+	}
+	if parsed.Title != "Movie Title" {
+		t.Errorf("Title failed, got: %s, want: Movie Title", parsed.Title)
+	}
+	if parsed.Year != 2010 {
+		t.Errorf("Year failed, got: %d, want: 2010", parsed.Year)
+	}
+	if parsed.Group != "GRP" {
+		t.Errorf("Group failed, got: %s, want: GRP", parsed.Group)
+	}
+	if parsed.Container != "mkv" {
+		t.Errorf("Container failed, got: %s, want: mkv", parsed.Container)
+	}
+}
@@ -2,14 +2,16 @@ package releaseparser
 
 import (
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var (
-	season     = `(?i)(s[0-9]{2}-s[0-9]{2}|s([0-9]{1,2})[eEx])|([Ss]?([0-9]{1,2}))[Eex]|([Ss]([0-9]{1,2}))`
-	episode    = `([Eex]([0-9]{2,4}-?[Eex]?[0-9]{2,4}))|([Eex]([0-9]{2,4}(?:[abc])?)(?:[^0-9]|$))|\b((?:[Eex]p?\.?)([0-9]{2,4}(:?-?(?:[Eex]?p?)[0-9]{2,4})?)|[Ee]pisode\s?([0-9]{1,4}))\b`
-	year       = `([\[\(]?((?:19[0-9]|20[01])[0-9])[\]\)]?)`
+	season     = `(?i)\b(?:season|staffel|saison)[. ]?[0-9]{1,2}(?:-[0-9]{1,2})?\b|(?:^|[^\pL])сезон[. ]?[0-9]{1,2}\b|(s[0-9]{2}-s[0-9]{2}|s([0-9]{1,2})[eEx])|([Ss]?([0-9]{1,2}))[Eex]|\b([Ss]([0-9]{1,2}))\b`
+	episode    = `([Eex]([0-9]{2,4}-?[Eex]?[0-9]{2,4})\b)|([Eex]([0-9]{2,4}(?:[abc])?)(?:[^0-9]|$))|\b((?:[Eex]p?\.?)([0-9]{2,4}(:?-?(?:[Eex]?p?)[0-9]{2,4})?)|[Ee]pisode\s?([0-9]{1,4}))\b|(?:^|[^\pL])(?i:серия)[. ]?([0-9]{1,4})\b`
+	year       = `([\[\(]?((?:19|20)[0-9]{2})[\]\)]?)`
 	resolution = `(?P<480p>480p|640x480|848x480)|(?P<576p>576p)|(?P<720p>720p|1280x720)|(?P<1080p>1080p|1920x1080)|(?P<2160p>2160p)`
 	source     = `(?i)\b(?:(?P<bdrip>BDRip)|(?P<brrip>BRRip)|(?P<bluray>BluRay|Blu-Ray|HDDVD|BD)|(?P<webdl>WEB[-_. ]DL|HDRIP|WEBDL|FUNi-DL|WebRip|Web-Rip|AmazonHD|NetflixHD|iTunesHD|WebHD|[. ]?WEB[. ](?:[xh]26[45]|DD5[. ]1)|\\d+0p[. ]WEB[. ])|(?P<hdtv>HDTV)|(?P<scr>SCR|SCREENER|DVDSCR|DVDSCREENER)|(?P<dvd>DVDRip|DVD[^-R]|NTSC|PAL|xvidvd)|(?P<dvdr>DVD-R|DVDR|DVD[0-9])|(?P<dsr>WS[-_. ]DSR|DSR)|(?P<ts>TS|TELESYNC|HD-TS|HDTS|PDVD\b)|(?P<tc>TC|TELECINE|HD-TC|HDTC)|(?P<cam>CAMRIP|CAM|HDCAM|HD-CAM)|(?P<wp>WORKPRINT|WP)|(?P<pdtv>PDTV)|(?P<sdtv>SDTV)|(?P<tvrip>(HD)?TVRip|[ad]TV))\b`
 	codec      = `(?i)(?P<x264>x264)|(?P<h264>h264)|(?P<h265>[xh]265|hevc)|(?P<xvidhd>XvidHD)|(?P<xvid>X-?vid)|(?P<divx>divx|mpeg[0-9])(?P<vp>vp(?:8|9))`
@@ -20,9 +22,9 @@ var (
 	extended   = `(?i)\bEXTENDED\b`
 	uncut      = `(?i)\bUNCUT\b`
 	hardcoded  = `(?i)\bHC\b`
-	proper     = `(?i)\bPROPER\b`
+	proper     = `(?i)\bPROPER[0-9]?\b`
 	subbed     = `(?i)subbed|ger[.-]?sub(s|ed)?|nlsub|eng-sub`
-	repack     = `(?i)\bREPACK\b`
+	repack     = `(?i)\bREPACK[0-9]?\b`
 	is3d       = `(?i)\b3d\b`
 	widescreen = `(?i)\bWS\b`
 	container  = `(?i)\b\.?(MKV|AVI|MP4|mkv|avi|mp4|m4v)\b`
@@ -31,8 +33,19 @@ var (
 	size       = `(\d+(?:\.\d+)?(?:GB|MB))`
 	language   = `(?i)\b(?:TRUE)?FR(?:ENCH)?\b|\bDE(?:UTSCH)?\b|\bGERMAN\b|\bEN(?:G(?:LISH)?)?\b|\bVOST(?:(F(?:R)?)|A)?\b|\bMULTI(?:Lang|Truefrench|\-VF2)?\b|\bSUBFRENCH\b|\bHindi\b`
 	password   = `(?i){{(?:[^{}]+)}}`
-	console    = `\b(XBOX|XBOX360|Wii|WiiU|PSP|PS4|NSW|PS3|NDS)\b`
+	console    = `\b(XBOX|XBOX360|XBOXONE|XBOX[. ]?SERIES|XB1|XSX|Wii|WiiU|PSP|PSV|PS4|PS5|NSW|PS3|NDS|3DS)\b`
 	version    = `(?i)v(\d+\.)(\d+)(\.\d+)?(\.\d+)?`
+	complete   = `(?i)\bCOMPLETE(?:[. -]SERIES)?\b`
+	part       = `(?i)\b(?:Part|Pt)[. _-]?([0-9]{1,2}|[IVX]{1,5})(?:[. _-]?(?:of|von)[. _-]?([0-9]{1,2}))?\b`
+	roman      = `(?i)^(?:XC|XL|L?X{0,3})(?:IX|IV|V?I{0,3})$`
+	disc       = `(?i)\b(?:CD|Disc|Disk)[. _-]?([0-9]{1,2})\b`
+	flags      = `(?i)\b(?:(?P<internal>INTERNAL)|(?P<limited>LIMITED)|(?P<readnfo>READ[. _-]?NFO)|(?P<dirfix>DIRFIX)|(?P<nfofix>NFOFIX)|(?P<samplefix>SAMPLEFIX)|(?P<subfix>SUBFIX)|(?P<syncfix>SYNCFIX)|(?P<real>REAL)[. _-](?:PROPER|REPACK|RERIP)|(?P<rerip>RERIP)|(?P<festival>FESTIVAL)|(?P<stv>STV))\b`
+	multiep    = `(?i)\b(?:S?[0-9]{1,2})?[EX][0-9]{2,4}(?:(?:[-+]?(?:[0-9]{1,2}X|[EX])|[-+])[0-9]{2,4})+\b`
+	animegroup = `^\[([^\].]+)\]`
+	animeep    = `(?:[\s_.]-[\s_.](\d{1,4})(?:\s?[-~]\s?(\d{1,4}))?(v\d+)?(?:[\s_.\[(]|$))|(?:\((\d{1,4})\s?[-~]\s?(\d{1,4})\))`
+	crc32      = `\[([0-9A-Fa-f]{8})\]`
+	airdate    = `\b(?:(?P<ymd>(?:19|20)[0-9]{2}[.\- ](?:0[1-9]|1[0-2])[.\- ](?:0[1-9]|[12][0-9]|3[01]))|(?P<dmy>(?:0[1-9]|[12][0-9]|3[01])[.\- ](?:0[1-9]|1[0-2])[.\- ](?:19|20)[0-9]{2})|(?P<yymd>[0-9]{2}[.\- ](?:0[1-9]|1[0-2])[.\- ](?:0[1-9]|[12][0-9]|3[01])))\b`
+	dubtype    = `(?i)\b(?:(?P<webdl>(?:WEB|FUNi)[-_. ]?DL)|(?P<md>(?:AC3)?MD|Mic[.-]?Dubbed)|(?P<ld>(?:AC3)?LD|LiNE(?:[.-]?Dubbed)?)|(?P<dl>DL)|(?P<dubbed>AC3D|Dubbed))\b`
 
 	regexlist = map[string]string{
 		"season":     season,
@@ -60,64 +73,144 @@ var (
 		"group":      group,
 		"console":    console,
 		"version":    version,
+		"dubtype":    dubtype,
+		"airdate":    airdate,
+		"complete":   complete,
+		"part":       part,
+		"disc":       disc,
+		"flags":      flags,
 	}
 
-	releaseTypePC      = "pc"
-	releaseTypeConsole = "console"
-	releaseTypeMovie   = "movie"
-	releaseTypeTV      = "tvshow"
+	releaseTypePC         = "pc"
+	releaseTypeConsole    = "console"
+	releaseTypeMovie      = "movie"
+	releaseTypeTV         = "tvshow"
+	releaseTypeAnime      = "anime"
+	releaseTypeMusic      = "music"
+	releaseTypeEbook      = "ebook"
+	releaseTypeAudiobook  = "audiobook"
+	releaseTypeComic      = "comic"
+	releaseTypeMagazine   = "magazine"
+	releaseTypeSports     = "sports"
+	releaseTypeMusicVideo = "musicvideo"
+	releaseTypeApp        = "app"
 
-	groupTypeMap = map[string]string{
-		"CODEX":      releaseTypePC,
-		"DARKSiDERS": releaseTypePC,
-		"PLAZA":      releaseTypePC,
-		"RAZOR":      releaseTypePC,
-		"SiMPLEX":    releaseTypePC,
-		"Razor1911":  releaseTypePC,
-		"HOODLUM":    releaseTypePC,
-		"SKIDROW":    releaseTypePC,
-		"ALiAS":      releaseTypePC,
+	// multi episode ranges longer than this are typos or other numbers ex: S01E01-E9999
+	maxEpisodeRange = 100
+
+	// later years are numbers in titles ex: Blade.Runner.2049, fixed so results don't depend on the current date
+	maxYear = 2039
+
+	// ranks the dub types, mic and line dubbed releases are downgrades compared to untagged ones
+	dubTypeRank = map[string]int{
+		"MD":     -2,
+		"LD":     -1,
+		"DUBBED": 1,
+		"DL":     2,
 	}
 )
 
 // Release represents a scene release
 type Release struct {
-	Input       string `json:"input,omitempty"`        // holds a copy of the input string
-	Title       string `json:"title,omitempty"`        // holds the release title without dots underscores and hypens
-	Type        string `json:"type,omitempty"`         // movie OR tvshow
-	Season      int    `json:"season,omitempty"`       // season number
-	SeasonEnd   int    `json:"season_end,omitempty"`   // 0 or end season for multi season releases
-	Episode     int    `json:"episode,omitempty"`      // episode number
-	EpisodeEnd  int    `json:"episode_end,omitempty"`  // 0 er end episode number for multi episode releases
-	Year        int    `json:"year,omitempty"`         // year
-	Resolution  string `json:"resolution,omitempty"`   // 720p, 1080p etc
-	Source      string `json:"source,omitempty"`       // the release source ex: BluRay, HDTV
-	SourceGroup string `json:"source_group,omitempty"` // normalized Source Name for textmatching (ex: Blu-Ray, BluRay, BD, HDDVD => BLURAY)
-	Codec       string `json:"codec,omitempty"`        // video codec ex: x264
-	CodecGroup  string `json:"codec_group,omitempty"`  // normalized Codec Name for textmatching (ex: divx => DIVX)
-	Audio       string `json:"audio,omitempty"`        // audio codec ex: FlAC, MP3, AC3
-	AudioGroup  string `json:"audio_group,omitempty"`  // normalized Audio Name for textmatching (ex: DD5.1,DD => DD)
-	Group       string `json:"group,omitempty"`        // the name of the releasegroup
-	Region      string `json:"region,omitempty"`       // contains Region info ex: R9
-	Container   string `json:"container,omitempty"`    // the container file format ex: mkv
-	Website     string `json:"website,omitempty"`      // the release website if in the name ex: [ my.site.com ]
-	Language    string `json:"language,omitempty"`     // language of the release ex: german, Spanish
-	Password    string `json:"password,omitempty"`     // if there is a password found it will be here
-	SBS         string `json:"sbs,omitempty"`          // Full-SBS or SBS
-	Size        string `json:"size,omitempty"`         // size if present in title
-	Doku        bool   `json:"doku,omitempty"`         // true if release is dokumentation
-	Extended    bool   `json:"extended,omitempty"`     // true if release is extended version
-	Hardcoded   bool   `json:"hardcoded,omitempty"`    // true if release is a Hardcoded release
-	Subbed      bool   `json:"subbed,omitempty"`       // true if release is subbed
-	Proper      bool   `json:"proper,omitempty"`       // true if release is proper
-	Repack      bool   `json:"repack,omitempty"`       // true if release is repack
-	Is3D        bool   `json:"is_3d,omitempty"`        // true if release is in 3D
-	Uncut       bool   `json:"uncut,omitempty"`        // true if release is uncut version
-	Widescreen  bool   `json:"widescreen,omitempty"`   // true if release is a widerscreen/letterbox release
-	Version     string `json:"version,omitempty"`      // contains version information if present
-	start       int
-	end         int
-	parts       map[string]string
+	Input            string          `json:"input,omitempty"`              // holds a copy of the input string
+	Title            string          `json:"title,omitempty"`              // holds the release title without dots underscores and hypens
+	AlternateTitles  []string        `json:"alternate_titles,omitempty"`   // alternate titles split by AKA ex: Movie.AKA.Other.Title => Other Title
+	Country          string          `json:"country,omitempty"`            // ISO 3166 code of regional versions ex: The.Office.US => US, Shameless.UK => GB
+	Type             string          `json:"type,omitempty"`               // movie, tvshow, anime, sports, music, musicvideo, ebook, audiobook, comic, magazine, app, pc OR console
+	TypeScores       []TypeScore     `json:"type_scores,omitempty"`        // all possible types sorted by the score of their evidence
+	Season           int             `json:"season,omitempty"`             // season number
+	SeasonEnd        int             `json:"season_end,omitempty"`         // 0 or end season for multi season releases
+	Seasons          []int           `json:"seasons,omitempty"`            // all seasons of the release ex: S01-S03 => 1, 2, 3
+	Episode          int             `json:"episode,omitempty"`            // episode number
+	EpisodeTitle     string          `json:"episode_title,omitempty"`      // title of the episode if present ex: Die Zombie Invasion
+	EpisodeEnd       int             `json:"episode_end,omitempty"`        // 0 er end episode number for multi episode releases
+	Episodes         []int           `json:"episodes,omitempty"`           // all episodes of the release ex: S01E01-E03 => 1, 2, 3
+	Year             int             `json:"year,omitempty"`               // year
+	AirDate          time.Time       `json:"air_date,omitzero"`            // air date of daily shows ex: The.Daily.Show.2020.07.06
+	Resolution       string          `json:"resolution,omitempty"`         // 720p, 1080p etc
+	Source           string          `json:"source,omitempty"`             // the release source ex: BluRay, HDTV
+	SourceGroup      string          `json:"source_group,omitempty"`       // normalized Source Name for textmatching (ex: Blu-Ray, BluRay, BD, HDDVD => BLURAY)
+	Codec            string          `json:"codec,omitempty"`              // video codec ex: x264
+	CodecGroup       string          `json:"codec_group,omitempty"`        // normalized Codec Name for textmatching (ex: divx => DIVX)
+	Audio            string          `json:"audio,omitempty"`              // audio codec ex: FlAC, MP3, AC3
+	AudioGroup       string          `json:"audio_group,omitempty"`        // normalized Audio Name for textmatching (ex: DD5.1,DD => DD)
+	Artist           string          `json:"artist,omitempty"`             // artist of music, music video and concert releases
+	Album            string          `json:"album,omitempty"`              // album of music releases
+	CatalogNumber    string          `json:"catalog_number,omitempty"`     // catalog number of music releases ex: CAT123
+	AudioFormat      string          `json:"audio_format,omitempty"`       // audio format of music releases ex: FLAC, MP3
+	Bitrate          string          `json:"bitrate,omitempty"`            // bitrate of music releases ex: 320, V0, 24bit/96kHz
+	Media            string          `json:"media,omitempty"`              // media of music releases ex: CD, WEB, VINYL, CDM, CDS
+	Performance      string          `json:"performance,omitempty"`        // performance of concerts and music videos ex: Live At Wembley, Song Title
+	Group            string          `json:"group,omitempty"`              // the name of the releasegroup
+	GroupCanonical   string          `json:"group_canonical,omitempty"`    // canonical name of the releasegroup ex: YIFY => YTS
+	Region           string          `json:"region,omitempty"`             // contains Region info ex: R9 or PAL, NTSC, USA, EUR, JPN for games
+	Container        string          `json:"container,omitempty"`          // the container file format ex: mkv
+	Website          string          `json:"website,omitempty"`            // the release website if in the name ex: [ my.site.com ]
+	Language         string          `json:"language,omitempty"`           // language of the release ex: german, Spanish
+	DubType          string          `json:"dub_type,omitempty"`           // normalized dub marker ex: DL, DUBBED, LD (line dubbed), MD (mic dubbed)
+	Password         string          `json:"password,omitempty"`           // if there is a password found it will be here
+	SBS              string          `json:"sbs,omitempty"`                // Full-SBS or SBS
+	Size             string          `json:"size,omitempty"`               // size if present in title
+	Doku             bool            `json:"doku,omitempty"`               // true if release is dokumentation
+	Extended         bool            `json:"extended,omitempty"`           // true if release is extended version
+	Hardcoded        bool            `json:"hardcoded,omitempty"`          // true if release is a Hardcoded release
+	Subbed           bool            `json:"subbed,omitempty"`             // true if release is subbed
+	Proper           bool            `json:"proper,omitempty"`             // true if release is proper
+	Repack           bool            `json:"repack,omitempty"`             // true if release is repack
+	Flags            map[string]bool `json:"flags,omitempty"`              // scene flags of the release ex: INTERNAL, LIMITED, DIRFIX, REAL
+	Internal         bool            `json:"internal,omitempty"`           // true if release is internal
+	Limited          bool            `json:"limited,omitempty"`            // true if release is limited
+	ReadNFO          bool            `json:"read_nfo,omitempty"`           // true if release is tagged READ.NFO
+	DirFix           bool            `json:"dir_fix,omitempty"`            // true if release is a DIRFIX
+	NFOFix           bool            `json:"nfo_fix,omitempty"`            // true if release is a NFOFIX
+	SampleFix        bool            `json:"sample_fix,omitempty"`         // true if release is a SAMPLEFIX
+	SubFix           bool            `json:"sub_fix,omitempty"`            // true if release is a SUBFIX
+	SyncFix          bool            `json:"sync_fix,omitempty"`           // true if release is a SYNCFIX
+	Real             bool            `json:"real,omitempty"`               // true if release is a REAL.PROPER or REAL.REPACK
+	Rerip            bool            `json:"rerip,omitempty"`              // true if release is a RERIP
+	Festival         bool            `json:"festival,omitempty"`           // true if release is a festival screener
+	STV              bool            `json:"stv,omitempty"`                // true if release is straight to video
+	Is3D             bool            `json:"is_3d,omitempty"`              // true if release is in 3D
+	Uncut            bool            `json:"uncut,omitempty"`              // true if release is uncut version
+	Widescreen       bool            `json:"widescreen,omitempty"`         // true if release is a widerscreen/letterbox release
+	IsSeasonPack     bool            `json:"is_season_pack,omitempty"`     // true if release contains one or more full seasons
+	IsCompleteSeries bool            `json:"is_complete_series,omitempty"` // true if release contains the complete series
+	IsSpecial        bool            `json:"is_special,omitempty"`         // true if release is a special (season 0) ex: S00E05
+	IsObfuscated     bool            `json:"is_obfuscated,omitempty"`      // true if the name is a hash or random string ex: a8f3c9e1b2d4.mkv
+	Part             int             `json:"part,omitempty"`               // part number of multi part releases ex: Part.2, Pt.II
+	PartTotal        int             `json:"part_total,omitempty"`         // total number of parts if present ex: Part.1.of.3
+	Disc             int             `json:"disc,omitempty"`               // disc number of multi disc releases ex: CD1, Disc3
+	Version          string          `json:"version,omitempty"`            // contains version information if present
+	VersionInfo      *VersionInfo    `json:"version_info,omitempty"`       // components of the version and build number if present
+	Platform         string          `json:"platform,omitempty"`           // normalized platform of games ex: NSW, PS4, XBOXONE, Win, Mac
+	Update           bool            `json:"update,omitempty"`             // true if release is a game update
+	DLC              bool            `json:"dlc,omitempty"`                // true if release is a DLC
+	Cracked          bool            `json:"cracked,omitempty"`            // true if release is cracked
+	Eshop            bool            `json:"eshop,omitempty"`              // true if release is from the nintendo eShop
+	GameFormat       string          `json:"game_format,omitempty"`        // image format of games ex: ISO, NSP, XCI
+	LanguageCount    int             `json:"language_count,omitempty"`     // number of languages ex: MULTi5 => 5
+	OS               string          `json:"os,omitempty"`                 // operating system of applications ex: Win, Mac, Linux
+	Architecture     string          `json:"architecture,omitempty"`       // cpu architecture of applications ex: x86, x64, ARM64
+	Multilingual     bool            `json:"multilingual,omitempty"`       // true if application is multilingual
+	Keygen           bool            `json:"keygen,omitempty"`             // true if application includes a keygen
+	Portable         bool            `json:"portable,omitempty"`           // true if application is portable
+	Retail           bool            `json:"retail,omitempty"`             // true if release is a retail version
+	Author           string          `json:"author,omitempty"`             // author of ebook and audiobook releases
+	BookFormat       string          `json:"book_format,omitempty"`        // file format of ebooks, audiobooks, comics and magazines ex: EPUB, PDF, CBZ, M4B
+	Issue            int             `json:"issue,omitempty"`              // issue number of comics and magazines ex: 001, #12, Issue.42
+	IssueDate        time.Time       `json:"issue_date,omitzero"`          // issue date of magazines ex: July.2020
+	Unabridged       bool            `json:"unabridged,omitempty"`         // true if audiobook is unabridged
+	League           string          `json:"league,omitempty"`             // canonical name of the league of sports releases ex: Formula1, NFL, UFC
+	Event            string          `json:"event,omitempty"`              // event of sports releases ex: Austrian Grand Prix, Seahawks vs Falcons
+	EventNumber      int             `json:"event_number,omitempty"`       // number of the event or round ex: UFC.251 => 251
+	Teams            []string        `json:"teams,omitempty"`              // teams of sports releases ex: Seahawks.vs.Falcons => Seahawks, Falcons
+	Session          string          `json:"session,omitempty"`            // normalized session of sports releases ex: Practice, Qualifying, Race
+	Revision         int             `json:"revision,omitempty"`           // revision of the release ex: PROPER => 1, REAL.PROPER => 2, REPACK2 => 2, v3 => 2
+	CRC32            string          `json:"crc32,omitempty"`              // crc32 checksum of anime releases ex: A1B2C3D4
+	Diagnostics      []string        `json:"diagnostics,omitempty"`        // notes about ambiguous parts of the release name ex: ambiguous year: 2012, 2009 => 2009
+	start            int
+	end              int
+	parts            map[string]string
 }
 
 // remove everything thats not a int from string
@@ -137,9 +230,9 @@ func (r *Release) part(name string, match string, clean string) {
 
 	if match != "" {
 		index := strings.Index(r.Input, clean)
-		if index <= 0 {
+		if index == 0 {
 			r.start = len(clean)
-		} else if r.end == 0 || index < r.end {
+		} else if index > 0 && (r.end == 0 || index < r.end) {
 			r.end = index
 		}
 	}
@@ -158,16 +251,501 @@ func getMatchedGroupName(re *regexp.Regexp, s string) string {
 	return ""
 }
 
+// picks the most relevant dub type from all matches, downgrades (MD, LD) win over everything else
+func getDubType(re *regexp.Regexp, matches []string) string {
+	dub := ""
+	for _, m := range matches {
+		name := getMatchedGroupName(re, m)
+		rank, ok := dubTypeRank[name]
+		if !ok {
+			// WEB-DL is a source not a dub marker
+			continue
+		}
+		current := dubTypeRank[dub]
+		if dub == "" || (rank < 0 && rank < current) || (current > 0 && rank > current) {
+			dub = name
+		}
+	}
+	return dub
+}
+
+// DubQuality returns the rank of the releases dub type, releases with a lower rank are of worse audio quality
+func (r *Release) DubQuality() int {
+	return dubTypeRank[r.DubType]
+}
+
+// picks the year of the release, numbers in titles like 2012, 1917 or Blade.Runner.2049 are kept in the title
+// by preferring the last plausible year in front of the first quality token and years in brackets ex: (2019)
+func (r *Release) selectYear() {
+	limit := r.end
+	if limit == 0 {
+		limit = len(r.Input)
+	}
+
+	type candidate struct {
+		match    string
+		year     int
+		index    int
+		brackets bool
+	}
+	before, after := []candidate{}, []candidate{}
+	years := []string{}
+	for _, loc := range regexp.MustCompile(year).FindAllStringSubmatchIndex(r.Input, -1) {
+		// years are never part of longer numbers
+		if loc[4] > 0 && isDigit(r.Input[loc[4]-1]) || loc[5] < len(r.Input) && isDigit(r.Input[loc[5]]) {
+			continue
+		}
+		c := candidate{r.Input[loc[0]:loc[1]], parseInt(r.Input[loc[4]:loc[5]]), loc[0], loc[0] != loc[4]}
+		if c.index < limit {
+			years = append(years, strconv.Itoa(c.year))
+		}
+		if c.year > maxYear {
+			continue
+		}
+		// the first word is always part of the title
+		if c.index == 0 {
+			continue
+		}
+		if c.index < limit {
+			before = append(before, c)
+		} else {
+			after = append(after, c)
+		}
+	}
+
+	var picked *candidate
+	for i := range before {
+		if picked == nil || !picked.brackets || before[i].brackets {
+			picked = &before[i]
+		}
+	}
+	if picked == nil && len(after) > 0 {
+		picked = &after[0]
+	}
+	if picked == nil {
+		return
+	}
+
+	if len(years) > 1 {
+		r.Diagnostics = append(r.Diagnostics, "ambiguous year: "+strings.Join(years, ", ")+" => "+strconv.Itoa(picked.year))
+	}
+
+	r.Year = picked.year
+	r.part("year", r.Input, picked.match)
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// sets the title based on the start and end position of the matched parts
+func (r *Release) setTitle() {
+	if r.end != 0 && r.end <= len(r.Input) && r.start < r.end {
+		r.Title = cleanTitle(r.Input[r.start:r.end])
+	}
+}
+
+// parses the fansub group, absolute episode, version and crc checksum of anime releases and returns
+// the string with group and checksum removed, non anime releases are returned unchanged
+func (r *Release) parseAnime(s string) string {
+	grp := regexp.MustCompile(animegroup).FindStringSubmatch(s)
+	if grp == nil {
+		return s
+	}
+	crcregex := regexp.MustCompile(crc32)
+	crc := crcregex.FindStringSubmatch(s)
+	ep := regexp.MustCompile(animeep).FindStringSubmatch(s)
+	if crc == nil && ep == nil {
+		return s
+	}
+
+	r.Type = releaseTypeAnime
+	r.Group = strings.Trim(grp[1], " ")
+	r.part("animegroup", r.Input, grp[0])
+	s = strings.Replace(s, grp[0], "", 1)
+
+	if crc != nil {
+		r.CRC32 = strings.ToUpper(crc[1])
+		r.part("crc32", r.Input, crc[0])
+		s = crcregex.ReplaceAllString(s, "")
+	}
+
+	if ep != nil {
+		if ep[1] != "" {
+			r.Episode = parseInt(ep[1])
+			r.EpisodeEnd = parseInt(ep[2])
+			r.Version = ep[3]
+		} else {
+			// batch range in parentheses ex: (01-12)
+			r.Episode = parseInt(ep[4])
+			r.EpisodeEnd = parseInt(ep[5])
+		}
+		r.part("animeep", r.Input, ep[0])
+	}
+
+	return s
+}
+
+// sets the flag and its convenience boolean
+func (r *Release) setFlag(flag string) {
+	if r.Flags == nil {
+		r.Flags = make(map[string]bool)
+	}
+	r.Flags[flag] = true
+
+	switch flag {
+	case "INTERNAL":
+		r.Internal = true
+	case "LIMITED":
+		r.Limited = true
+	case "READNFO":
+		r.ReadNFO = true
+	case "DIRFIX":
+		r.DirFix = true
+	case "NFOFIX":
+		r.NFOFix = true
+	case "SAMPLEFIX":
+		r.SampleFix = true
+	case "SUBFIX":
+		r.SubFix = true
+	case "SYNCFIX":
+		r.SyncFix = true
+	case "REAL":
+		r.Real = true
+	case "RERIP":
+		r.Rerip = true
+	case "FESTIVAL":
+		r.Festival = true
+	case "STV":
+		r.STV = true
+	}
+}
+
+// parses the scene flags which follow the title and are followed by other tags, flag words in titles are kept
+// ex: Show.S01E05.INTERNAL.720p but not Show.S01E05.Internal.Affairs.720p or Limited.2010.720p
+func (r *Release) parseFlags() {
+	re := regexp.MustCompile(flags)
+	name := strings.ReplaceAll(r.Input, "_", ".")
+	end := r.end
+	for _, loc := range re.FindAllStringIndex(name, -1) {
+		if end == 0 || loc[0] < end || !r.startsWithTag(name[loc[1]:]) {
+			continue
+		}
+		m := name[loc[0]:loc[1]]
+		r.setFlag(getMatchedGroupName(re, m))
+		// REAL is matched together with the tag it repeats ex: REAL.RERIP
+		if regexp.MustCompile(`(?i)RERIP$`).MatchString(m) {
+			r.setFlag("RERIP")
+		}
+		r.part("flags", r.Input, m)
+	}
+}
+
+// true if the string is empty or starts with a matched part or a flag, leading separators are skipped
+func (r *Release) startsWithTag(s string) bool {
+	if strings.Trim(s, "._- ") == "" {
+		return true
+	}
+	for _, t := range []string{strings.TrimLeft(s, "._ "), strings.TrimLeft(s, "._- ")} {
+		if loc := regexp.MustCompile(flags).FindStringIndex(t); loc != nil && loc[0] == 0 {
+			return true
+		}
+		for _, p := range r.parts {
+			if p != "" && strings.HasPrefix(t, p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasFlag returns true if the release has the given scene flag ex: INTERNAL
+func (r *Release) HasFlag(flag string) bool {
+	return r.Flags[strings.ToUpper(flag)]
+}
+
+// IsFix returns true for releases that only fix the dir name, nfo, sample or subs and dont contain the content itself
+func (r *Release) IsFix() bool {
+	return r.DirFix || r.NFOFix || r.SampleFix || r.SubFix
+}
+
+// calculates the revision from proper, repack and rerip tags or the version of anime releases
+func (r *Release) revision() int {
+	revision := 0
+	for _, name := range []string{"proper", "repack"} {
+		if p, ok := r.parts[name]; ok {
+			// PROPER and REPACK are the first revision, REPACK2 the second
+			n := parseInt(p)
+			if n == 0 {
+				n = 1
+			}
+			if n > revision {
+				revision = n
+			}
+		}
+	}
+	if r.Rerip && revision == 0 {
+		revision = 1
+	}
+	if r.Real {
+		revision++
+	}
+
+	//anime releases count versions, v2 is the first revision
+	if r.Type == releaseTypeAnime && r.Version != "" {
+		if n := parseInt(r.Version) - 1; n > revision {
+			revision = n
+		}
+	}
+	return revision
+}
+
+// IsUpgradeOf returns true if r replaces the content of old, fixes like DIRFIX or NFOFIX never replace content
+func (r *Release) IsUpgradeOf(old *Release) bool {
+	if r.IsFix() {
+		return false
+	}
+	return r.Revision > old.Revision
+}
+
+// ContentKey returns a key that is equal for releases of the same content in the same quality
+func (r *Release) ContentKey() string {
+	key := []string{
+		r.SearchTitle(),
+		r.Country,
+		strconv.Itoa(r.Year),
+		strconv.Itoa(r.Season),
+		strconv.Itoa(r.Episode),
+		strconv.Itoa(r.EpisodeEnd),
+		r.AirDate.Format("2006-01-02"),
+		strconv.Itoa(r.Part),
+		strconv.Itoa(r.Disc),
+		r.Resolution,
+		r.SourceGroup,
+		r.CodecGroup,
+		strings.ToLower(r.Language),
+		r.DubType,
+		strconv.FormatBool(r.Is3D),
+		strconv.FormatBool(r.Extended),
+		strconv.FormatBool(r.Uncut),
+	}
+	return strings.Join(key, "|")
+}
+
+// Supersedes returns true if new should replace the already downloaded old release because it has
+// the same content, is from the same group and has a higher revision
+func Supersedes(old, new *Release) bool {
+	if old.ContentKey() != new.ContentKey() || !strings.EqualFold(old.Group, new.Group) {
+		return false
+	}
+	return new.IsUpgradeOf(old)
+}
+
+// converts roman numerals to int, returns 0 for invalid numerals
+func parseRoman(s string) int {
+	if s == "" || !regexp.MustCompile(roman).MatchString(s) {
+		return 0
+	}
+	values := map[rune]int{'I': 1, 'V': 5, 'X': 10, 'L': 50, 'C': 100}
+
+	result, last := 0, 0
+	for _, c := range strings.ToUpper(s) {
+		value, ok := values[c]
+		if !ok {
+			return 0
+		}
+		result += value
+		// subtractive notation ex: IV, IX
+		if last > 0 && last < value {
+			result -= 2 * last
+		}
+		last = value
+	}
+	return result
+}
+
+// parses the date of date based episodes, layout is the name of the matched airdate group
+func parseAirDate(layout string, s string) time.Time {
+	nums := regexp.MustCompile(`[0-9]+`).FindAllString(s, -1)
+	if len(nums) != 3 {
+		return time.Time{}
+	}
+
+	var year, month, day int
+	switch layout {
+	case "YMD":
+		year, month, day = parseInt(nums[0]), parseInt(nums[1]), parseInt(nums[2])
+	case "DMY":
+		day, month, year = parseInt(nums[0]), parseInt(nums[1]), parseInt(nums[2])
+	case "YYMD":
+		year, month, day = 1900+parseInt(nums[0]), parseInt(nums[1]), parseInt(nums[2])
+		// two digit years follow the POSIX convention, 69-99 are 19xx and 00-68 are 20xx
+		if year < 1969 {
+			year += 100
+		}
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	// time.Date normalizes invalid dates like 31.02. so make sure we got what we asked for
+	if date.Day() != day {
+		return time.Time{}
+	}
+	return date
+}
+
+// returns all episodes covered by the release, multi episode strings like S01E01E02E03, S01E01-E03,
+// 1x01-1x03 or S01E01+E02 update Episode and EpisodeEnd to the lowest and highest episode
+func (r *Release) episodeList(s string) []int {
+	episodes := []int{}
+
+	match := r.multiEpisode(s)
+	if match == "" {
+		end := r.EpisodeEnd
+		if end-r.Episode > maxEpisodeRange {
+			end = r.Episode
+			r.EpisodeEnd = 0
+		}
+		if end < r.Episode {
+			end = r.Episode
+		}
+		for e := r.Episode; e <= end; e++ {
+			episodes = append(episodes, e)
+		}
+		return episodes
+	}
+	r.part("multiep", r.Input, match)
+
+	//cut season in front of the first episode
+	match = regexp.MustCompile(`(?i)^S?[0-9]{1,2}([EX])`).ReplaceAllString(match, "$1")
+	for _, m := range regexp.MustCompile(`(?i)([-+]?)(?:[0-9]{1,2}X|[EX])?([0-9]{2,4})`).FindAllStringSubmatch(match, -1) {
+		e := parseInt(m[2])
+		if m[1] == "-" && len(episodes) > 0 {
+			last := episodes[len(episodes)-1]
+			// reversed and implausible long ranges are typos ex: S01E05-E03, S01E01-E9999
+			if e < last || e-last > maxEpisodeRange {
+				continue
+			}
+			// ranges contain every episode between the last one and e
+			for i := last + 1; i < e; i++ {
+				episodes = append(episodes, i)
+			}
+		}
+		episodes = append(episodes, e)
+	}
+
+	sort.Ints(episodes)
+	r.Episode = episodes[0]
+	r.EpisodeEnd = 0
+	if len(episodes) > 1 {
+		r.EpisodeEnd = episodes[len(episodes)-1]
+	}
+	return episodes
+}
+
+// returns the multi episode string around the matched episode, codecs are skipped ex: x264-2020
+func (r *Release) multiEpisode(s string) string {
+	ep, ok := r.parts["episode"]
+	if !ok {
+		return ""
+	}
+	index := strings.Index(s, ep)
+	for _, loc := range regexp.MustCompile(multiep).FindAllStringIndex(s, -1) {
+		if loc[0] <= index && index < loc[1] && !isCodecToken(s[loc[0]:loc[1]]) {
+			return s[loc[0]:loc[1]]
+		}
+	}
+	return ""
+}
+
+// true for codecs and cpu architectures which look like episodes ex: x264, x64
+func isCodecToken(s string) bool {
+	return regexp.MustCompile(codec).MatchString(s) || regexp.MustCompile(`(?i)^x(?:64|86)\b`).MatchString(s)
+}
+
+// returns all seasons covered by the release
+func (r *Release) seasonList() []int {
+	seasons := []int{}
+	end := r.SeasonEnd
+	if end < r.Season {
+		end = r.Season
+	}
+	for season := r.Season; season <= end; season++ {
+		seasons = append(seasons, season)
+	}
+	return seasons
+}
+
+// returns the text between the episode marker and the next matched part
+func (r *Release) episodeTitle() string {
+	markers := map[string]bool{"season": true, "episode": true, "multiep": true, "animeep": true, "airdate": true}
+
+	start := -1
+	for name := range markers {
+		if p, ok := r.parts[name]; ok {
+			if index := strings.Index(r.Input, p); index >= 0 && index+len(p) > start {
+				start = index + len(p)
+			}
+		}
+	}
+	if start < 0 {
+		return ""
+	}
+
+	end := len(r.Input)
+	for name, p := range r.parts {
+		if markers[name] {
+			continue
+		}
+		// parts are whole tokens, the first occurrence can be inside a word of the title ex: DL in DLC
+		if index := indexToken(r.Input[start:], p); index >= 0 && start+index < end {
+			end = start + index
+		}
+	}
+	// subtitle tags of scandinavian releases aren't matched as part ex: DKsubs, SWEsubs
+	if loc := regexp.MustCompile(`(?i)(?:^|[._ -])[a-z]{2,3}subs\b`).FindStringIndex(r.Input[start:end]); loc != nil {
+		end = start + loc[0]
+	}
+
+	return cleanTitle(r.Input[start:end])
+}
+
+// returns the index of the first occurrence of token in s which isn't part of a longer word
+// ex: DL in Show.S01E02.The.DLC.Problem.German.DL.720p
+func indexToken(s, token string) int {
+	for offset := 0; offset < len(s); {
+		index := strings.Index(s[offset:], token)
+		if index < 0 || token == "" {
+			return -1
+		}
+		index += offset
+		end := index + len(token)
+		if (index == 0 || !isWordByte(s[index-1]) || !isWordByte(token[0])) &&
+			(end == len(s) || !isWordByte(s[end]) || !isWordByte(token[len(token)-1])) {
+			return index
+		}
+		offset = index + 1
+	}
+	return -1
+}
+
+func isWordByte(b byte) bool {
+	return isDigit(b) || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
 func cleanTitle(name string) string {
 	name = strings.Replace(name, ".", " ", -1)
 	name = strings.Replace(name, "_", " ", -1)
 	name = strings.Replace(name, "-", " ", -1)
 	name = strings.Trim(name, " ")
+	// opening brackets of the first tag that follows the title ex: Title [1080p]
+	name = strings.TrimRight(name, " [(")
 	return name
 }
 
 // Parse parses the given release name
 func Parse(s string) *Release {
+	input := s
+	s = normalize(s)
 	r := Release{Input: s, parts: make(map[string]string)}
 
 	//cut password from string because this might mess up correct detection of other infos...
@@ -179,11 +757,19 @@ func Parse(s string) *Release {
 		s = pwregex.ReplaceAllString(s, "")
 	}
 
+	//anime releases start with the fansub group in brackets which would be detected as website otherwise
+	s = r.parseAnime(s)
+
+	//cut tracker tags and obfuscation suffixes so the real group is found
+	s = regexp.MustCompile(groupsuffix).ReplaceAllString(s, "$1")
+
+	s = r.parseSiteTag(s)
+
 	s = strings.ReplaceAll(s, "_", ".")
 
 	for name, str := range regexlist {
 		re := regexp.MustCompile(str)
-		if loc := re.FindStringIndex(s); loc != nil {
+		if loc := findToken(re, s); loc != nil {
 			match := s[loc[0]:loc[1]]
 			switch name {
 			case "season":
@@ -193,10 +779,15 @@ func Parse(s string) *Release {
 				}
 				r.Season = parseInt(seasons[0])
 			case "episode":
-				//if make sure we dont match codec as episode
-				if !regexp.MustCompile(codec).MatchString(match) {
+				if r.Type == releaseTypeAnime && r.Episode > 0 {
+					continue
+				}
+				// the separator after the episode number isn't part of it ex: e01.mkv
+				match = strings.TrimRight(match, "._- [(")
+				//make sure we dont match codec or cpu architecture (x64, x86) as episode
+				if !isCodecToken(match) {
 					//remove episode becuase it gets split otherwise
-					clean := regexp.MustCompile("(?i)episode").ReplaceAllString(match, "")
+					clean := regexp.MustCompile("(?i)episode|серия").ReplaceAllString(match, "")
 					//split multiep strings
 					tmp := regexp.MustCompile(`(?i)(\.|-|ep|e|x)`).Split(clean, -1)
 					episodes := []string{}
@@ -209,9 +800,36 @@ func Parse(s string) *Release {
 					if len(episodes) > 1 {
 						r.EpisodeEnd = parseInt(episodes[1])
 					}
+				} else {
+					// dont mark the codec or architecture as episode part
+					continue
+				}
+			case "airdate":
+				r.AirDate = parseAirDate(getMatchedGroupName(re, match), match)
+				if r.AirDate.IsZero() {
+					continue
+				}
+			case "complete":
+				r.IsCompleteSeries = regexp.MustCompile(`(?i)series`).MatchString(match)
+			case "part":
+				m := re.FindStringSubmatch(match)
+				r.Part = parseInt(m[1])
+				if r.Part == 0 {
+					r.Part = parseRoman(m[1])
 				}
+				// invalid numerals belong to the title ex: Part.VX
+				if r.Part == 0 {
+					continue
+				}
+				r.PartTotal = parseInt(m[2])
+			case "disc":
+				r.Disc = parseInt(match)
+			case "flags":
+				// flags are parsed after the title end is known, see parseFlags
+				continue
 			case "year":
-				r.Year = parseInt(match)
+				// years are selected after all other parts are known, see selectYear
+				continue
 			case "version":
 				r.Version = match
 			case "resolution":
@@ -226,23 +844,33 @@ func Parse(s string) *Release {
 				r.Audio = match
 				r.AudioGroup = getMatchedGroupName(re, match)
 			case "group":
-				// if codec or source is in group skip it
-				if regexp.MustCompile(codec).MatchString(match) || regexp.MustCompile(source).MatchString(match) || regexp.MustCompile(language).MatchString(match) {
+				// anime releases have the group in front
+				if r.Type == releaseTypeAnime {
+					continue
+				}
+				// if codec or source is in group skip it, same for quality tags in brackets ex: [WEBDL-1080p]
+				if regexp.MustCompile(codec).MatchString(match) || regexp.MustCompile(source).MatchString(match) || regexp.MustCompile(language).MatchString(match) ||
+					regexp.MustCompile(resolution).MatchString(match) || strings.Contains(match, "]") && !strings.Contains(match, "[") {
 					continue
 				} else {
 					r.Group = strings.Replace(match, "-", "", 1)
-					r.Group = regexp.MustCompile(container).ReplaceAllString(r.Group, "")
+					r.Group = cleanGroup(r.Group)
 				}
 			case "region":
 				r.Region = match
 			case "console":
-				r.Type = releaseTypeConsole
+				// evidence for the console type, see classifyTokens
 			case "container":
 				r.Container = strings.Replace(match, ".", "", -1)
 			case "website":
+				if r.Type == releaseTypeAnime {
+					continue
+				}
 				r.Website = match
 			case "language":
 				r.Language = match
+			case "dubtype":
+				r.DubType = getDubType(re, re.FindAllString(s, -1))
 			case "sbs":
 				r.SBS = match
 			case "size":
@@ -271,24 +899,75 @@ func Parse(s string) *Release {
 		}
 	}
 
-	if r.end != 0 && r.end <= len(r.Input) && r.start < r.end {
-		r.Title = cleanTitle(r.Input[r.start:r.end])
+	r.selectYear()
+	r.parseFlags()
+	r.setTitle()
+
+	if p, ok := r.parts["season"]; ok {
+		r.IsSpecial = r.Season == 0 && strings.Contains(p, "0")
 	}
 
-	if r.Season > 0 || r.Episode > 0 && r.Episode != parseInt(r.Codec) {
-		r.Type = releaseTypeTV
-	} else {
-		for g, t := range groupTypeMap {
-			if r.Group == g {
-				r.Type = t
-			}
-		}
+	//complete episodes without season are the complete series otherwise a complete season, complete alone
+	//is no tv evidence ex: Soul.Eater.Ep.01-51.Complete but Avatar.2009.COMPLETE.BLURAY is a full disc
+	if _, ok := r.parts["complete"]; ok && r.Season == 0 && r.Episode > 0 && !r.IsSpecial {
+		r.IsCompleteSeries = true
+	}
 
-		if r.Type == "" {
-			r.Type = releaseTypeMovie
+	// type and episode of anime releases are already set by parseAnime
+	if r.Type != releaseTypeAnime {
+		r.TypeScores = classify(&r)
+		r.Type = r.TypeScores[0].Type
+		if r.Type != releaseTypeTV {
 			r.Episode = 0
 		}
 	}
 
+	switch r.Type {
+	case releaseTypeMusic:
+		r.parseMusic()
+	case releaseTypeMusicVideo:
+		r.parseMusicVideo()
+	case releaseTypeConsole, releaseTypePC:
+		r.parseGame(s)
+	case releaseTypeApp:
+		r.parseApp(s)
+	case releaseTypeEbook, releaseTypeAudiobook, releaseTypeComic, releaseTypeMagazine:
+		r.parseBook(s)
+	case releaseTypeSports:
+		r.parseSports(s)
+	}
+
+	switch r.Type {
+	case releaseTypeMovie, releaseTypeTV, releaseTypeAnime:
+		r.splitTitle()
+	}
+
+	if r.Episode > 0 {
+		r.Episodes = r.episodeList(s)
+	}
+
+	if _, ok := r.parts["season"]; ok && (r.Type == releaseTypeTV || r.Type == releaseTypeAnime) {
+		r.Seasons = r.seasonList()
+		r.IsSeasonPack = r.Episode == 0 && r.AirDate.IsZero()
+	}
+
+	r.Revision = r.revision()
+
+	if r.Group != "" {
+		r.GroupCanonical = CanonicalGroup(r.Group)
+	}
+
+	if (r.Type == releaseTypeTV || r.Type == releaseTypeAnime) && (r.Episode > 0 || !r.AirDate.IsZero()) {
+		r.EpisodeTitle = r.episodeTitle()
+	}
+
+	// everything parsed from obfuscated names is nonsense ex: a8f3c9e1b2d4.mkv isn't season 9
+	if r.obfuscated() {
+		r = Release{Input: r.Input, Type: releaseTypeMovie, Container: r.Container, IsObfuscated: true}
+	}
+
+	// parts are matched against the normalized name but the input is kept as given
+	r.Input = input
+
 	return &r
 }
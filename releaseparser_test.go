@@ -1,198 +1,263 @@
 package releaseparser_test
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/cytec/releaseparser"
 )
 
+func TestIsObfuscated(t *testing.T) {
+	test := map[string]bool{
+		"a8f3c9e1b2d4.mkv":                         true,
+		"0f8fad5b-d9cb-469f-a165-70867728950e.mkv": true,
+		"Xk3j9LmQ2pR7sT1v.mkv":                     true,
+		"abc.xyz.mkv":                              true,
+		"xkcdqrtzp.mkv":                            true,
+		"the.fly.mkv":                              false,
+		"up.mkv":                                   false,
+		"her.mkv":                                  false,
+		"Shrek2.mkv":                               false,
+		"Tron3D.mkv":                               false,
+		"BBC.Documentary.mkv":                      false,
+		"deadbeef.mkv":                             false,
+	}
+
+	for name, want := range test {
+		if got := releaseparser.Parse(name).IsObfuscated; got != want {
+			t.Errorf("IsObfuscated failed for %s, got: %t, want: %t", name, got, want)
+		}
+	}
+}
+
 func TestParse(t *testing.T) {
 	test := map[string]*releaseparser.Release{
 		"Winx.Club.S06E16.Die.Zombie-Invasion.GERMAN.DUBBED.DL.720p.WEB-DL.h264-pbw": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Winx Club",
-			Season:      6,
-			Episode:     16,
-			Language:    "GERMAN",
-			Source:      "WEB-DL",
-			SourceGroup: "WEBDL",
-			Codec:       "h264",
-			CodecGroup:  "H264",
-			Group:       "pbw",
-			Resolution:  "720p",
+			Type:           "tvshow",
+			Title:          "Winx Club",
+			Season:         6,
+			Seasons:        []int{6},
+			Episode:        16,
+			Episodes:       []int{16},
+			EpisodeTitle:   "Die Zombie Invasion",
+			Language:       "GERMAN",
+			DubType:        "DL",
+			Source:         "WEB-DL",
+			SourceGroup:    "WEBDL",
+			Codec:          "h264",
+			CodecGroup:     "H264",
+			Group:          "pbw",
+			GroupCanonical: "pbw",
+			Resolution:     "720p",
 		},
 		"Scouts.vs.Zombies.Handbuch.zur.Zombie.Apokalypse.2015.German.AC3.DL.1080p.BluRay.x264-EXQUiSiTE": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Scouts vs Zombies Handbuch zur Zombie Apokalypse",
-			Year:        2015,
-			Language:    "German",
-			Source:      "BluRay",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Group:       "EXQUiSiTE",
-			Resolution:  "1080p",
-			Audio:       "AC3",
+			Type:           "movie",
+			Title:          "Scouts vs Zombies Handbuch zur Zombie Apokalypse",
+			Year:           2015,
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "BluRay",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Group:          "EXQUiSiTE",
+			GroupCanonical: "EXQUiSiTE",
+			Resolution:     "1080p",
+			Audio:          "AC3",
 		},
 		"Zombie.Bloody.Demons.UNCUT.GERMAN.1987.DL.1080p.BluRay.x264-GOREHOUNDS": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Zombie Bloody Demons",
-			Year:        1987,
-			Language:    "GERMAN",
-			Source:      "BluRay",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Group:       "GOREHOUNDS",
-			Resolution:  "1080p",
-			Uncut:       true,
+			Type:           "movie",
+			Title:          "Zombie Bloody Demons",
+			Year:           1987,
+			Language:       "GERMAN",
+			DubType:        "DL",
+			Source:         "BluRay",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Group:          "GOREHOUNDS",
+			GroupCanonical: "GOREHOUNDS",
+			Resolution:     "1080p",
+			Uncut:          true,
 		},
 		"iZombie.S02E10.Zombie.High.German.DD51.Dubbed.DL.720p.BD.x264-TVS": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "iZombie",
-			Season:      2,
-			Episode:     10,
-			Language:    "German",
-			Source:      "BD",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Group:       "TVS",
-			Audio:       "DD51",
-			Resolution:  "720p",
+			Type:           "tvshow",
+			Title:          "iZombie",
+			Season:         2,
+			Seasons:        []int{2},
+			Episode:        10,
+			Episodes:       []int{10},
+			EpisodeTitle:   "Zombie High",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "BD",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Group:          "TVS",
+			GroupCanonical: "TVS",
+			Audio:          "DD51",
+			Resolution:     "720p",
 		},
 		"iZombie.S02E10.Zombie.High.German.DD51.Dubbed.DL.720p.BD.x264-TVS{{s3cre7p455wd!}}": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "iZombie",
-			Season:      2,
-			Episode:     10,
-			Language:    "German",
-			Source:      "BD",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Group:       "TVS",
-			Audio:       "DD51",
-			Resolution:  "720p",
-			Password:    "s3cre7p455wd!",
+			Type:           "tvshow",
+			Title:          "iZombie",
+			Season:         2,
+			Seasons:        []int{2},
+			Episode:        10,
+			Episodes:       []int{10},
+			EpisodeTitle:   "Zombie High",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "BD",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Group:          "TVS",
+			GroupCanonical: "TVS",
+			Audio:          "DD51",
+			Resolution:     "720p",
+			Password:       "s3cre7p455wd!",
 		},
 		"Brave.2012.R5.DVDRip.XViD.LiNE-UNiQUE": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Brave",
-			Year:        2012,
-			Source:      "DVDRip",
-			Codec:       "XViD",
-			SourceGroup: "DVD",
-			CodecGroup:  "XVID",
-			Group:       "UNiQUE",
-			Region:      "R5",
-			Audio:       "LiNE",
+			Type:           "movie",
+			Title:          "Brave",
+			Year:           2012,
+			Source:         "DVDRip",
+			Codec:          "XViD",
+			SourceGroup:    "DVD",
+			CodecGroup:     "XVID",
+			Group:          "UNiQUE",
+			GroupCanonical: "UNiQUE",
+			Region:         "R5",
+			Audio:          "LiNE",
+			DubType:        "LD",
 		},
 		"Brave.2012.German.Subbed.DVDRip.XViD.LiNE-UNiQUE": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Brave",
-			Year:        2012,
-			Source:      "DVDRip",
-			Codec:       "XViD",
-			SourceGroup: "DVD",
-			CodecGroup:  "XVID",
-			Group:       "UNiQUE",
-			Language:    "German",
-			Subbed:      true,
-			Audio:       "LiNE",
+			Type:           "movie",
+			Title:          "Brave",
+			Year:           2012,
+			Source:         "DVDRip",
+			Codec:          "XViD",
+			SourceGroup:    "DVD",
+			CodecGroup:     "XVID",
+			Group:          "UNiQUE",
+			GroupCanonical: "UNiQUE",
+			Language:       "German",
+			DubType:        "LD",
+			Subbed:         true,
+			Audio:          "LiNE",
 		},
 		"Ant-Man.2015.3D.1080p.BRRip.Half-SBS.x264.AAC-m2g": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Ant Man",
-			Year:        2015,
-			Source:      "BRRip",
-			Codec:       "x264",
-			SourceGroup: "BRRIP",
-			CodecGroup:  "X264",
-			Group:       "m2g",
-			SBS:         "Half-SBS",
-			Audio:       "AAC",
-			Resolution:  "1080p",
-			Is3D:        true,
+			Type:           "movie",
+			Title:          "Ant Man",
+			Year:           2015,
+			Source:         "BRRip",
+			Codec:          "x264",
+			SourceGroup:    "BRRIP",
+			CodecGroup:     "X264",
+			Group:          "m2g",
+			GroupCanonical: "m2g",
+			SBS:            "Half-SBS",
+			Audio:          "AAC",
+			Resolution:     "1080p",
+			Is3D:           true,
 		},
 		"Annabelle.2014.1080p.PROPER.HC.WEBRip.x264.AAC.2.0-RARBG": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Annabelle",
-			Year:        2014,
-			Source:      "WEBRip",
-			Codec:       "x264",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "X264",
-			Group:       "RARBG",
-			Audio:       "AAC.2.0",
-			Resolution:  "1080p",
-			Proper:      true,
-			Hardcoded:   true,
+			Type:           "movie",
+			Title:          "Annabelle",
+			Year:           2014,
+			Source:         "WEBRip",
+			Codec:          "x264",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "X264",
+			Group:          "RARBG",
+			GroupCanonical: "RARBG",
+			Audio:          "AAC.2.0",
+			Resolution:     "1080p",
+			Proper:         true,
+			Revision:       1,
+			Hardcoded:      true,
 		},
 		"The.Boss.2016.UNCUT.720p.BRRip.x264.AAC-ETRG": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "The Boss",
-			Year:        2016,
-			Source:      "BRRip",
-			Codec:       "x264",
-			SourceGroup: "BRRIP",
-			CodecGroup:  "X264",
-			Group:       "ETRG",
-			Audio:       "AAC",
-			Resolution:  "720p",
-			Uncut:       true,
+			Type:           "movie",
+			Title:          "The Boss",
+			Year:           2016,
+			Source:         "BRRip",
+			Codec:          "x264",
+			SourceGroup:    "BRRIP",
+			CodecGroup:     "X264",
+			Group:          "ETRG",
+			GroupCanonical: "ETRG",
+			Audio:          "AAC",
+			Resolution:     "720p",
+			Uncut:          true,
 		},
 		"Hercules.2014.EXTENDED.1080p.WEB-DL.DD5.1.H264-RARBG": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Hercules",
-			Year:        2014,
-			Source:      "WEB-DL",
-			Codec:       "H264",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "H264",
-			Group:       "RARBG",
-			Audio:       "DD5.1",
-			Resolution:  "1080p",
-			Extended:    true,
+			Type:           "movie",
+			Title:          "Hercules",
+			Year:           2014,
+			Source:         "WEB-DL",
+			Codec:          "H264",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "H264",
+			Group:          "RARBG",
+			GroupCanonical: "RARBG",
+			Audio:          "DD5.1",
+			Resolution:     "1080p",
+			Extended:       true,
 		},
 		"1-2-3.Istanbul.S01E04.GERMAN.DOKU.WS.dTV.XviD-GEO": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "1 2 3 Istanbul",
-			Season:      1,
-			Episode:     4,
-			Language:    "GERMAN",
-			Source:      "dTV",
-			Codec:       "XviD",
-			SourceGroup: "TVRIP",
-			CodecGroup:  "XVID",
-			Group:       "GEO",
-			Widescreen:  true,
-			Doku:        true,
+			Type:           "tvshow",
+			Title:          "1 2 3 Istanbul",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        4,
+			Episodes:       []int{4},
+			Language:       "GERMAN",
+			Source:         "dTV",
+			Codec:          "XviD",
+			SourceGroup:    "TVRIP",
+			CodecGroup:     "XVID",
+			Group:          "GEO",
+			GroupCanonical: "GEO",
+			Widescreen:     true,
+			Doku:           true,
 		},
 		"[ www.Speed.cd ] -Sons.of.Anarchy.S07E07.720p.HDTV.X264-DIMENSION": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Sons of Anarchy",
-			Season:      7,
-			Episode:     7,
-			Resolution:  "720p",
-			Source:      "HDTV",
-			Codec:       "X264",
-			SourceGroup: "HDTV",
-			CodecGroup:  "X264",
-			Group:       "DIMENSION",
-			Website:     "[ www.Speed.cd ]",
+			Type:           "tvshow",
+			Title:          "Sons of Anarchy",
+			Season:         7,
+			Seasons:        []int{7},
+			Episode:        7,
+			Episodes:       []int{7},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			Codec:          "X264",
+			SourceGroup:    "HDTV",
+			CodecGroup:     "X264",
+			Group:          "DIMENSION",
+			GroupCanonical: "DIMENSION",
+			Website:        "[ www.Speed.cd ]",
 		},
 		"Two and a Half Men S12E01 HDTV x264 REPACK-LOL [eztv]": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Two and a Half Men",
-			Season:      12,
-			Episode:     1,
-			Source:      "HDTV",
-			Codec:       "x264",
-			SourceGroup: "HDTV",
-			CodecGroup:  "X264",
-			Group:       "LOL [eztv]",
-			Repack:      true,
+			Type:           "tvshow",
+			Title:          "Two and a Half Men",
+			Season:         12,
+			Seasons:        []int{12},
+			Episode:        1,
+			Episodes:       []int{1},
+			Source:         "HDTV",
+			Codec:          "x264",
+			SourceGroup:    "HDTV",
+			CodecGroup:     "X264",
+			Group:          "LOL",
+			GroupCanonical: "LOL",
+			Repack:         true,
+			Revision:       1,
 		},
 		"Eliza Graves (2014) Dual Audio WEB-DL 720p MKV x264": &releaseparser.Release{
 			Type:        "movie",
@@ -218,135 +283,683 @@ func TestParse(t *testing.T) {
 			Audio:       "AAC",
 		},
 		"Mr Robot S02E11 German DD 51 Synced DL 1080p AmazonHD x264-TVS": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Mr Robot",
-			Season:      2,
-			Episode:     11,
-			Group:       "TVS",
-			Resolution:  "1080p",
-			Language:    "German",
-			Source:      "AmazonHD",
-			Codec:       "x264",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "X264",
-			Audio:       "DD 51",
+			Type:           "tvshow",
+			Title:          "Mr Robot",
+			Season:         2,
+			Seasons:        []int{2},
+			Episode:        11,
+			Episodes:       []int{11},
+			Group:          "TVS",
+			GroupCanonical: "TVS",
+			Resolution:     "1080p",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "AmazonHD",
+			Codec:          "x264",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "X264",
+			Audio:          "DD 51",
 		},
 		"31.A.Rob.Zombie.Film.3D.UNCUT.2016.German.DL.1080p.BluRay.x264-ETM": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "31 A Rob Zombie Film",
-			Year:        2016,
-			Group:       "ETM",
-			Resolution:  "1080p",
-			Language:    "German",
-			Source:      "BluRay",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Is3D:        true,
-			Uncut:       true,
+			Type:           "movie",
+			Title:          "31 A Rob Zombie Film",
+			Year:           2016,
+			Group:          "ETM",
+			GroupCanonical: "ETM",
+			Resolution:     "1080p",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "BluRay",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Is3D:           true,
+			Uncut:          true,
 		},
 		"Zombie Shark The Swimming Dead French 2015 AC3 BDRiP x264-XF": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Zombie Shark The Swimming Dead",
-			Year:        2015,
-			Group:       "XF",
-			Language:    "French",
-			Source:      "BDRiP",
-			Codec:       "x264",
-			SourceGroup: "BDRIP",
-			CodecGroup:  "X264",
-			Audio:       "AC3",
+			Type:           "movie",
+			Title:          "Zombie Shark The Swimming Dead",
+			Year:           2015,
+			Group:          "XF",
+			GroupCanonical: "XF",
+			Language:       "French",
+			Source:         "BDRiP",
+			Codec:          "x264",
+			SourceGroup:    "BDRIP",
+			CodecGroup:     "X264",
+			Audio:          "AC3",
 		},
 		"Dracula.Untold.TS.XViD.AC3.MrSeeN-SiMPLE": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Dracula Untold",
-			Group:       "SiMPLE",
-			Source:      "TS",
-			Codec:       "XViD",
-			SourceGroup: "TS",
-			CodecGroup:  "XVID",
-			Audio:       "AC3",
+			Type:           "movie",
+			Title:          "Dracula Untold",
+			Group:          "SiMPLE",
+			GroupCanonical: "SiMPLE",
+			Source:         "TS",
+			Codec:          "XViD",
+			SourceGroup:    "TS",
+			CodecGroup:     "XVID",
+			Audio:          "AC3",
 		},
 		"Mr.Robot.S01.PROPER.VOSTFR.720p.WEB-DL.DD5.1.H264-ARK01": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Mr Robot",
-			Season:      1,
-			Group:       "ARK01",
-			Language:    "VOSTFR",
-			Source:      "WEB-DL",
-			Codec:       "H264",
-			Audio:       "DD5.1",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "H264",
-			Resolution:  "720p",
-			Proper:      true,
+			Type:           "tvshow",
+			Title:          "Mr Robot",
+			Season:         1,
+			Seasons:        []int{1},
+			IsSeasonPack:   true,
+			Group:          "ARK01",
+			GroupCanonical: "ARK01",
+			Language:       "VOSTFR",
+			Source:         "WEB-DL",
+			Codec:          "H264",
+			Audio:          "DD5.1",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "H264",
+			Resolution:     "720p",
+			Proper:         true,
+			Revision:       1,
 		},
 		"What.Happened.to.Monday.UNCUT.German.DL.AC3.Dubbed.720p.WEBRiP.x264-PsO": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "What Happened to Monday",
-			Group:       "PsO",
-			Language:    "German",
-			Source:      "WEBRiP",
-			Codec:       "x264",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "X264",
-			Audio:       "AC3",
-			Resolution:  "720p",
-			Uncut:       true,
+			Type:           "movie",
+			Title:          "What Happened to Monday",
+			Group:          "PsO",
+			GroupCanonical: "PsO",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "WEBRiP",
+			Codec:          "x264",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "X264",
+			Audio:          "AC3",
+			Resolution:     "720p",
+			Uncut:          true,
 		},
 		"Skins.S06E10.Finale.German.DD20.Dubbed.DL.720p.AmazonHD.x264-TVS": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Skins",
-			Season:      6,
-			Episode:     10,
-			Group:       "TVS",
-			Language:    "German",
-			Source:      "AmazonHD",
-			Codec:       "x264",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "X264",
-			Audio:       "DD20",
-			Resolution:  "720p",
+			Type:           "tvshow",
+			Title:          "Skins",
+			Season:         6,
+			Seasons:        []int{6},
+			Episode:        10,
+			Episodes:       []int{10},
+			EpisodeTitle:   "Finale",
+			Group:          "TVS",
+			GroupCanonical: "TVS",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "AmazonHD",
+			Codec:          "x264",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "X264",
+			Audio:          "DD20",
+			Resolution:     "720p",
+		},
+		"Split.2016.German.AC3LD.720p.CAM.x264-PsO": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Split",
+			Year:           2016,
+			Group:          "PsO",
+			GroupCanonical: "PsO",
+			Language:       "German",
+			DubType:        "LD",
+			Source:         "CAM",
+			Codec:          "x264",
+			SourceGroup:    "CAM",
+			CodecGroup:     "X264",
+			Audio:          "AC3",
+			Resolution:     "720p",
+		},
+		"Dunkirk.2017.German.MD.DL.TS.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Dunkirk",
+			Year:           2017,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+			Language:       "German",
+			DubType:        "MD",
+			Source:         "TS",
+			Codec:          "x264",
+			SourceGroup:    "TS",
+			CodecGroup:     "X264",
+		},
+		"Sonic.2019.German.Mic-Dubbed.TS.XViD-ABC": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Sonic",
+			Year:           2019,
+			Group:          "ABC",
+			GroupCanonical: "ABC",
+			Language:       "German",
+			DubType:        "MD",
+			Source:         "TS",
+			Codec:          "XViD",
+			SourceGroup:    "TS",
+			CodecGroup:     "XVID",
+		},
+		"Joker.2019.German.LD.DVDRip.XviD-PsO": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Joker",
+			Year:           2019,
+			Group:          "PsO",
+			GroupCanonical: "PsO",
+			Language:       "German",
+			DubType:        "LD",
+			Source:         "DVDRip",
+			Codec:          "XviD",
+			SourceGroup:    "DVD",
+			CodecGroup:     "XVID",
+		},
+		"Hellboy.2019.German.AC3MD.720p.HDCAM.x264-ABC": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Hellboy",
+			Year:           2019,
+			Group:          "ABC",
+			GroupCanonical: "ABC",
+			Language:       "German",
+			DubType:        "MD",
+			Source:         "HDCAM",
+			Codec:          "x264",
+			SourceGroup:    "CAM",
+			CodecGroup:     "X264",
+			Audio:          "AC3",
+			Resolution:     "720p",
 		},
 		"Quality for Movie.Title.2004.PAL.DVD9-IL.Anonymous-DownRev": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Quality for Movie Title",
-			Year:        2004,
-			Group:       "DownRev",
-			Source:      "PAL",
-			SourceGroup: "DVD",
+			Type:           "movie",
+			Title:          "Quality for Movie Title",
+			Year:           2004,
+			Group:          "DownRev",
+			GroupCanonical: "DownRev",
+			Source:         "PAL",
+			SourceGroup:    "DVD",
 		},
 		"Movie.Title.2015.DVD-R-Pate": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Movie Title",
-			Year:        2015,
-			Group:       "Pate",
-			Source:      "DVD-R",
-			SourceGroup: "DVDR",
+			Type:           "movie",
+			Title:          "Movie Title",
+			Year:           2015,
+			Group:          "Pate",
+			GroupCanonical: "Pate",
+			Source:         "DVD-R",
+			SourceGroup:    "DVDR",
 		},
 		"The.X-Files.S01-S03.DKsubs.1080p.BluRay.HEVC.x265": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "The X Files",
-			Season:      1,
-			SeasonEnd:   3,
-			Resolution:  "1080p",
-			Source:      "BluRay",
-			SourceGroup: "BLURAY",
-			Codec:       "HEVC",
-			CodecGroup:  "H265",
+			Type:         "tvshow",
+			Title:        "The X Files",
+			Season:       1,
+			SeasonEnd:    3,
+			Seasons:      []int{1, 2, 3},
+			IsSeasonPack: true,
+			Resolution:   "1080p",
+			Source:       "BluRay",
+			SourceGroup:  "BLURAY",
+			Codec:        "HEVC",
+			CodecGroup:   "H265",
 		},
 		"The.X-Files.S01E01-E03.DKsubs.1080p.BluRay.HEVC.x265": &releaseparser.Release{
 			Type:        "tvshow",
 			Title:       "The X Files",
 			Season:      1,
+			Seasons:     []int{1},
 			Episode:     1,
 			EpisodeEnd:  3,
+			Episodes:    []int{1, 2, 3},
 			Resolution:  "1080p",
 			Source:      "BluRay",
 			SourceGroup: "BLURAY",
 			Codec:       "HEVC",
 			CodecGroup:  "H265",
 		},
+		"Show.S01E02.The.DLC.Problem.German.DL.720p.WEB.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Show",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        2,
+			Episodes:       []int{2},
+			EpisodeTitle:   "The DLC Problem",
+			Language:       "German",
+			DubType:        "DL",
+			Resolution:     "720p",
+			Source:         ".WEB.x264",
+			SourceGroup:    "WEBDL",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"The.Simpsons.S05E01E02E03.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Simpsons",
+			Season:         5,
+			Seasons:        []int{5},
+			Episode:        1,
+			EpisodeEnd:     3,
+			Episodes:       []int{1, 2, 3},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Show.S02E03.x264-2020-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Show",
+			Season:         2,
+			Seasons:        []int{2},
+			Episode:        3,
+			Episodes:       []int{3},
+			Year:           2020,
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Show.S01E01-E9999.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Show",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Show.S01E05-E03.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Show",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        5,
+			Episodes:       []int{5},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"The.Simpsons.S05E04-E06.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Simpsons",
+			Season:         5,
+			Seasons:        []int{5},
+			Episode:        4,
+			EpisodeEnd:     6,
+			Episodes:       []int{4, 5, 6},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"The.Simpsons.5x07-5x09.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Simpsons",
+			Season:         5,
+			Seasons:        []int{5},
+			Episode:        7,
+			EpisodeEnd:     9,
+			Episodes:       []int{7, 8, 9},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"The.Simpsons.S05E10+E11.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Simpsons",
+			Season:         5,
+			Seasons:        []int{5},
+			Episode:        10,
+			EpisodeEnd:     11,
+			Episodes:       []int{10, 11},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Two.and.a.Half.Men.S12E01.HDTV.x264-LOL": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Two and a Half Men",
+			Season:         12,
+			Seasons:        []int{12},
+			Episode:        1,
+			Episodes:       []int{1},
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "LOL",
+			GroupCanonical: "LOL",
+		},
+		"Dark.S03.COMPLETE.German.DL.1080p.WEB-DL.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Dark",
+			Season:         3,
+			Seasons:        []int{3},
+			IsSeasonPack:   true,
+			Language:       "German",
+			DubType:        "DL",
+			Resolution:     "1080p",
+			Source:         "WEB-DL",
+			SourceGroup:    "WEBDL",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Dark.Staffel.1-3.German.DL.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Dark",
+			Season:         1,
+			SeasonEnd:      3,
+			Seasons:        []int{1, 2, 3},
+			IsSeasonPack:   true,
+			Language:       "German",
+			DubType:        "DL",
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Friends.Season.2.720p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Friends",
+			Season:         2,
+			Seasons:        []int{2},
+			IsSeasonPack:   true,
+			Resolution:     "720p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Friends.Complete.Series.720p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:             "tvshow",
+			Title:            "Friends",
+			IsCompleteSeries: true,
+			Resolution:       "720p",
+			Source:           "BluRay",
+			SourceGroup:      "BLURAY",
+			Codec:            "x264",
+			CodecGroup:       "X264",
+			Group:            "GRP",
+			GroupCanonical:   "GRP",
+		},
+		"Avatar.2009.COMPLETE.BLURAY-UNTOUCHED": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Avatar",
+			Year:           2009,
+			Source:         "BLURAY",
+			SourceGroup:    "BLURAY",
+			Group:          "UNTOUCHED",
+			GroupCanonical: "UNTOUCHED",
+		},
+		"Inception.2010.COMPLETE.UHD.BLURAY-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Source:         "BLURAY",
+			SourceGroup:    "BLURAY",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Doctor.Who.S00E05.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Doctor Who",
+			Episode:        5,
+			Episodes:       []int{5},
+			IsSpecial:      true,
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+			Seasons:        []int{0},
+		},
+		"Kill.Bill.2003.CD1.DVDRip.XviD-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Kill Bill",
+			Year:           2003,
+			Disc:           1,
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Kill.Bill.2003.Disc3.DVDR-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Kill Bill",
+			Year:           2003,
+			Disc:           3,
+			Source:         "DVDR",
+			SourceGroup:    "DVDR",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Kill.Bill.Pt.II.2004.DVDRip.XviD-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Kill Bill",
+			Year:           2004,
+			Part:           2,
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Movie.Part.VX.2010.DVDRip.XviD-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie Part VX",
+			Year:           2010,
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Planet.Erde.Part.1.of.3.German.DOKU.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Planet Erde",
+			Part:           1,
+			PartTotal:      3,
+			Language:       "German",
+			Doku:           true,
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Inception.2010.INTERNAL.LIMITED.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Flags:          map[string]bool{"INTERNAL": true, "LIMITED": true},
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Inception.2010.READ.NFO.DIRFIX.DVDRip.XviD-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Flags:          map[string]bool{"READNFO": true, "DIRFIX": true},
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Skins.S01E01.REAL.PROPER.720p.HDTV.x264-TVS": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Skins",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			Proper:         true,
+			Flags:          map[string]bool{"REAL": true},
+			Revision:       2,
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "TVS",
+			GroupCanonical: "TVS",
+		},
+		"Movie.2010.REAL.RERIP.720p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie",
+			Year:           2010,
+			Flags:          map[string]bool{"REAL": true, "RERIP": true},
+			Revision:       2,
+			Resolution:     "720p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Show.S01E05.Internal.Affairs.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Show",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        5,
+			Episodes:       []int{5},
+			EpisodeTitle:   "Internal Affairs",
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Limited.2010.720p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Limited",
+			Year:           2010,
+			Resolution:     "720p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Real.Steel.2011.FESTIVAL.STV.DVDRip.XviD-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Real Steel",
+			Year:           2011,
+			Flags:          map[string]bool{"FESTIVAL": true, "STV": true},
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Skins.S01E02.REPACK2.720p.HDTV.x264-TVS": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Skins",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        2,
+			Episodes:       []int{2},
+			Repack:         true,
+			Revision:       2,
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "TVS",
+			GroupCanonical: "TVS",
+		},
+		"Inception.2010.1080p.BluRay.x264-SPARKS[rarbg]": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "SPARKS",
+			GroupCanonical: "SPARKS",
+		},
+		"Inception.2010.1080p.BluRay.x264-SPARKS-Obfuscated": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "SPARKS",
+			GroupCanonical: "SPARKS",
+		},
+		"Inception.2010.1080p.BluRay.x264-SPARKS-xpost.mkv": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "SPARKS",
+			GroupCanonical: "SPARKS",
+			Container:      "mkv",
+		},
+		"Inception.2010.1080p.BluRay.x264-YIFY": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Inception",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "YIFY",
+			GroupCanonical: "YTS",
+		},
 		"Lucy 2014 Dual-Audio WEBRip 900MB": &releaseparser.Release{
 			Type:        "movie",
 			Title:       "Lucy",
@@ -357,134 +970,1125 @@ func TestParse(t *testing.T) {
 			Size:        "900MB",
 		},
 		"Soul.Eater.Ep.01-51.Complete.German.AC3.DL.720p.BluRay.x264-AST4u": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Soul Eater",
-			Language:    "German",
-			Source:      "BluRay",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Episode:     1,
-			EpisodeEnd:  51,
-			Group:       "AST4u",
-			Resolution:  "720p",
-			Audio:       "AC3",
+			Type:             "tvshow",
+			Title:            "Soul Eater",
+			Language:         "German",
+			DubType:          "DL",
+			Source:           "BluRay",
+			Codec:            "x264",
+			SourceGroup:      "BLURAY",
+			CodecGroup:       "X264",
+			Episode:          1,
+			EpisodeEnd:       51,
+			Episodes:         []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35, 36, 37, 38, 39, 40, 41, 42, 43, 44, 45, 46, 47, 48, 49, 50, 51},
+			IsCompleteSeries: true,
+			Group:            "AST4u",
+			GroupCanonical:   "AST4u",
+			Resolution:       "720p",
+			Audio:            "AC3",
 		},
 		"Soul.Eater.Ep.02.German.AC3.DL.720p.BluRay.x264-AST4u": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Soul Eater",
-			Language:    "German",
-			Source:      "BluRay",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Episode:     2,
-			Group:       "AST4u",
-			Resolution:  "720p",
-			Audio:       "AC3",
+			Type:           "tvshow",
+			Title:          "Soul Eater",
+			Language:       "German",
+			DubType:        "DL",
+			Source:         "BluRay",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Episode:        2,
+			Episodes:       []int{2},
+			Group:          "AST4u",
+			GroupCanonical: "AST4u",
+			Resolution:     "720p",
+			Audio:          "AC3",
 		},
 		"Trinity.Seven.S01.E12.German.2014.ANiME.DTS.DL.1080p.BluRay.x264-ShadowTX.mkv": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Trinity Seven",
-			Language:    "German",
-			Season:      1,
-			Year:        2014,
-			Source:      "BluRay",
-			Codec:       "x264",
-			SourceGroup: "BLURAY",
-			CodecGroup:  "X264",
-			Episode:     12,
-			Group:       "ShadowTX",
-			Container:   "mkv",
-			Resolution:  "1080p",
-			Audio:       "DTS",
+			Type:           "tvshow",
+			Title:          "Trinity Seven",
+			Language:       "German",
+			DubType:        "DL",
+			Season:         1,
+			Seasons:        []int{1},
+			Year:           2014,
+			Source:         "BluRay",
+			Codec:          "x264",
+			SourceGroup:    "BLURAY",
+			CodecGroup:     "X264",
+			Episode:        12,
+			Episodes:       []int{12},
+			Group:          "ShadowTX",
+			GroupCanonical: "ShadowTX",
+			Container:      "mkv",
+			Resolution:     "1080p",
+			Audio:          "DTS",
 		},
 		"Fairy.Tail.E024.Um.ihre.Traenen.nicht.zu.sehen.German.2009.ANiME.DL.BDRiP.x264-STARS": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Fairy Tail",
-			Language:    "German",
-			Year:        2009,
-			Source:      "BDRiP",
-			Codec:       "x264",
-			SourceGroup: "BDRIP",
-			CodecGroup:  "X264",
-			Episode:     24,
-			Group:       "STARS",
+			Type:           "tvshow",
+			Title:          "Fairy Tail",
+			Language:       "German",
+			DubType:        "DL",
+			Year:           2009,
+			Source:         "BDRiP",
+			Codec:          "x264",
+			SourceGroup:    "BDRIP",
+			CodecGroup:     "X264",
+			Episode:        24,
+			Episodes:       []int{24},
+			EpisodeTitle:   "Um ihre Traenen nicht zu sehen",
+			Group:          "STARS",
+			GroupCanonical: "STARS",
 		},
 		"Fairy.Tail.E009.Natsu.verschlingt.ein.Dorf.German.2009.ANiME.DL.BDRiP.x264-STARS": &releaseparser.Release{
-			Type:        "tvshow",
-			Title:       "Fairy Tail",
-			Language:    "German",
-			Year:        2009,
-			Source:      "BDRiP",
-			Codec:       "x264",
-			SourceGroup: "BDRIP",
-			CodecGroup:  "X264",
-			Episode:     9,
-			Group:       "STARS",
+			Type:           "tvshow",
+			Title:          "Fairy Tail",
+			Language:       "German",
+			DubType:        "DL",
+			Year:           2009,
+			Source:         "BDRiP",
+			Codec:          "x264",
+			SourceGroup:    "BDRIP",
+			CodecGroup:     "X264",
+			Episode:        9,
+			Episodes:       []int{9},
+			EpisodeTitle:   "Natsu verschlingt ein Dorf",
+			Group:          "STARS",
+			GroupCanonical: "STARS",
 		},
 		"Black Sabbath The End of the End 2017 720p WEB H264-STRiFE{{reAmy0r0vphpzAnch0it5tZoykb6mZ5s}}": &releaseparser.Release{
-			Type:        "movie",
-			Title:       "Black Sabbath The End of the End",
-			Year:        2017,
-			Source:      "WEB H264",
-			Codec:       "H264",
-			SourceGroup: "WEBDL",
-			CodecGroup:  "H264",
-			Resolution:  "720p",
-			Group:       "STRiFE",
-			Password:    "reAmy0r0vphpzAnch0it5tZoykb6mZ5s",
+			Type:           "movie",
+			Title:          "Black Sabbath The End of the End",
+			Year:           2017,
+			Source:         "WEB H264",
+			Codec:          "H264",
+			SourceGroup:    "WEBDL",
+			CodecGroup:     "H264",
+			Resolution:     "720p",
+			Group:          "STRiFE",
+			GroupCanonical: "STRiFE",
+			Password:       "reAmy0r0vphpzAnch0it5tZoykb6mZ5s",
 		},
 		"Tokyo Ghoul: RE S2 - Episode 4 VOSTFR (1080p)": &releaseparser.Release{
 			Type:       "tvshow",
 			Title:      "Tokyo Ghoul: RE",
 			Season:     2,
+			Seasons:    []int{2},
 			Episode:    4,
+			Episodes:   []int{4},
 			Resolution: "1080p",
 			Language:   "VOSTFR",
 		},
+		"[SubsPlease] Jujutsu Kaisen - 24 (1080p) [A1B2C3D4].mkv": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Jujutsu Kaisen",
+			Episode:        24,
+			Episodes:       []int{24},
+			Resolution:     "1080p",
+			Group:          "SubsPlease",
+			GroupCanonical: "SubsPlease",
+			Container:      "mkv",
+			CRC32:          "A1B2C3D4",
+		},
+		"[HorribleSubs] One Punch Man S2 - 05v2 [720p].mkv": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "One Punch Man",
+			Season:         2,
+			Seasons:        []int{2},
+			Episode:        5,
+			Episodes:       []int{5},
+			Version:        "v2",
+			Revision:       1,
+			Resolution:     "720p",
+			Group:          "HorribleSubs",
+			GroupCanonical: "HorribleSubs",
+			Container:      "mkv",
+		},
+		"[Erai-raws] Kimetsu no Yaiba - 01 ~ 26 [1080p][Multiple Subtitle]": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Kimetsu no Yaiba",
+			Episode:        1,
+			EpisodeEnd:     26,
+			Episodes:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26},
+			Resolution:     "1080p",
+			Group:          "Erai-raws",
+			GroupCanonical: "Erai-raws",
+		},
+		"[Coalgirls] Clannad (01-23) [1080p Blu-ray FLAC] [3F2C1A0B]": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Clannad",
+			Episode:        1,
+			EpisodeEnd:     23,
+			Episodes:       []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23},
+			Resolution:     "1080p",
+			Source:         "Blu-ray",
+			SourceGroup:    "BLURAY",
+			Audio:          "FLAC",
+			Group:          "Coalgirls",
+			GroupCanonical: "Coalgirls",
+			CRC32:          "3F2C1A0B",
+		},
+		"[Judas]_Mob_Psycho_100_-_07_[x265][0A1B2C3D].mkv": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Mob Psycho 100",
+			Episode:        7,
+			Episodes:       []int{7},
+			Codec:          "x265",
+			CodecGroup:     "H265",
+			Group:          "Judas",
+			GroupCanonical: "Judas",
+			Container:      "mkv",
+			CRC32:          "0A1B2C3D",
+		},
+		"The.Daily.Show.2020.07.06.Guest.Name.720p.HDTV.x264-SORNY": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Daily Show",
+			Year:           2020,
+			AirDate:        time.Date(2020, 7, 6, 0, 0, 0, 0, time.UTC),
+			EpisodeTitle:   "Guest Name",
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "SORNY",
+			GroupCanonical: "SORNY",
+		},
+		"The.Tonight.Show.Starring.Jimmy.Fallon.2019-11-05.Guest.720p.HDTV.x264-SORNY": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Tonight Show Starring Jimmy Fallon",
+			Year:           2019,
+			AirDate:        time.Date(2019, 11, 5, 0, 0, 0, 0, time.UTC),
+			EpisodeTitle:   "Guest",
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "SORNY",
+			GroupCanonical: "SORNY",
+		},
+		"Tagesschau.06.07.2019.German.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Tagesschau",
+			Year:           2019,
+			AirDate:        time.Date(2019, 7, 6, 0, 0, 0, 0, time.UTC),
+			Language:       "German",
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Conan.20.07.06.Some.Guest.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Conan",
+			AirDate:        time.Date(2020, 7, 6, 0, 0, 0, 0, time.UTC),
+			EpisodeTitle:   "Some Guest",
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Late.Night.with.Conan.OBrien.98.09.14.Some.Guest.DSR.XviD-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Late Night with Conan OBrien",
+			AirDate:        time.Date(1998, 9, 14, 0, 0, 0, 0, time.UTC),
+			EpisodeTitle:   "Some Guest",
+			Source:         "DSR",
+			SourceGroup:    "DSR",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist_Name-Album_Title-(CAT123)-WEB-2019-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Album Title",
+			Artist:         "Artist Name",
+			Album:          "Album Title",
+			CatalogNumber:  "CAT123",
+			Media:          "WEB",
+			Year:           2019,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist-Album-24BIT-96KHZ-WEB-FLAC-2019-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Album",
+			Artist:         "Artist",
+			Album:          "Album",
+			AudioFormat:    "FLAC",
+			Audio:          "FLAC",
+			Bitrate:        "24bit/96kHz",
+			Media:          "WEB",
+			Year:           2019,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Daft_Punk-Random_Access_Memories-CD-FLAC-2013-PERFECT": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Random Access Memories",
+			Artist:         "Daft Punk",
+			Album:          "Random Access Memories",
+			AudioFormat:    "FLAC",
+			Audio:          "FLAC",
+			Media:          "CD",
+			Year:           2013,
+			Group:          "PERFECT",
+			GroupCanonical: "PERFECT",
+		},
+		"Artist-Single_Title-(ABC001)-VLS-2018-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Single Title",
+			Artist:         "Artist",
+			Album:          "Single Title",
+			CatalogNumber:  "ABC001",
+			Media:          "VINYL",
+			Year:           2018,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist-Album-(Deluxe_Edition)-WEB-2020-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Album - (Deluxe Edition)",
+			Artist:         "Artist",
+			Album:          "Album - (Deluxe Edition)",
+			Media:          "WEB",
+			Year:           2020,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Some.Band-Greatest.Hits-VINYL-FLAC-1999-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Greatest Hits",
+			Artist:         "Some Band",
+			Album:          "Greatest Hits",
+			AudioFormat:    "FLAC",
+			Audio:          "FLAC",
+			Media:          "VINYL",
+			Year:           1999,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist-Album-WEB-MP3-V0-2019-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Album",
+			Artist:         "Artist",
+			Album:          "Album",
+			AudioFormat:    "MP3",
+			Audio:          "MP3",
+			Bitrate:        "V0",
+			Media:          "WEB",
+			Year:           2019,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist-Album-320-CDM-DE-2019-GRP": &releaseparser.Release{
+			Type:           "music",
+			Title:          "Album",
+			Artist:         "Artist",
+			Album:          "Album",
+			Bitrate:        "320",
+			Media:          "CDM",
+			Language:       "DE",
+			Year:           2019,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
 		"ARK.Survival.Evolved.Extinction-CODEX": &releaseparser.Release{
-			Type:  "pc",
-			Title: "ARK Survival Evolved Extinction",
-			Group: "CODEX",
+			Type:           "pc",
+			Title:          "ARK Survival Evolved Extinction",
+			Group:          "CODEX",
+			GroupCanonical: "CODEX",
 		},
 		"The.Swindle.eShop.NSW-SUXXORS": &releaseparser.Release{
-			Type:  "console",
-			Title: "The Swindle eShop",
-			Group: "SUXXORS",
+			Type:           "console",
+			Title:          "The Swindle",
+			Platform:       "NSW",
+			Eshop:          true,
+			Group:          "SUXXORS",
+			GroupCanonical: "SUXXORS",
 		},
 		"Earth.Defense.Force.Insect.Armageddon.NTSC.XBOX360-COMPLEX": &releaseparser.Release{
-			Type:        "console",
-			Title:       "Earth Defense Force Insect Armageddon",
-			Group:       "COMPLEX",
-			Source:      "NTSC",
-			SourceGroup: "DVD",
+			Type:           "console",
+			Title:          "Earth Defense Force Insect Armageddon",
+			Platform:       "XBOX360",
+			Region:         "NTSC",
+			Group:          "COMPLEX",
+			GroupCanonical: "COMPLEX",
 		},
 		"Crusty.Demons.Freestyle.Moto.X.USA.DVDRiP.XBOX-GGS": &releaseparser.Release{
-			Type:        "console",
-			Title:       "Crusty Demons Freestyle Moto X USA",
-			Group:       "GGS",
-			Source:      "DVDRiP",
-			SourceGroup: "DVD",
+			Type:           "console",
+			Title:          "Crusty Demons Freestyle Moto X",
+			Platform:       "XBOX",
+			Region:         "USA",
+			Group:          "GGS",
+			GroupCanonical: "GGS",
+			Source:         "DVDRiP",
+			SourceGroup:    "DVD",
 		},
 		"Diablo_III_Eternal_Collection_Update_v2.6.9.68709_NSW-VENOM": &releaseparser.Release{
-			Type:    "console",
-			Title:   "Diablo III Eternal Collection Update",
-			Version: "v2.6.9.68709",
-			Group:   "VENOM",
+			Type:           "console",
+			Title:          "Diablo III Eternal Collection",
+			Platform:       "NSW",
+			Update:         true,
+			Version:        "v2.6.9.68709",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 2, Minor: 6, Patch: 9, Build: 68709},
+			Group:          "VENOM",
+			GroupCanonical: "VENOM",
 		},
 		"SAMURAI_SHODOWN_MULTI_Update_v1.90_NSW-SUXXORS": &releaseparser.Release{
-			Type:     "console",
-			Title:    "SAMURAI SHODOWN",
-			Version:  "v1.90",
-			Language: "MULTI",
-			Group:    "SUXXORS",
+			Type:           "console",
+			Title:          "SAMURAI SHODOWN",
+			Platform:       "NSW",
+			Update:         true,
+			Version:        "v1.90",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 1, Minor: 90},
+			Language:       "MULTI",
+			Group:          "SUXXORS",
+			GroupCanonical: "SUXXORS",
 		},
 		"Dead.Dungeon.v1.0.11-SiMPLEX": &releaseparser.Release{
-			Type:    "pc",
-			Title:   "Dead Dungeon",
-			Version: "v1.0.11",
-			Group:   "SiMPLEX",
+			Type:           "pc",
+			Title:          "Dead Dungeon",
+			Version:        "v1.0.11",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 1, Minor: 0, Patch: 11},
+			Group:          "SiMPLEX",
+			GroupCanonical: "SiMPLEX",
+		},
+		"Super.Mario.Odyssey.EUR.MULTi5.NSW.XCI-BigBlueBox": &releaseparser.Release{
+			Type:           "console",
+			Title:          "Super Mario Odyssey",
+			Platform:       "NSW",
+			Region:         "EUR",
+			LanguageCount:  5,
+			GameFormat:     "XCI",
+			Group:          "BigBlueBox",
+			GroupCanonical: "BigBlueBox",
+		},
+		"Some.Game.DLC.Pack.PS4-DUPLEX": &releaseparser.Release{
+			Type:           "console",
+			Title:          "Some Game",
+			Platform:       "PS4",
+			DLC:            true,
+			Group:          "DUPLEX",
+			GroupCanonical: "DUPLEX",
+		},
+		"Game.Name.PS4-DUPLEX": &releaseparser.Release{
+			Type:           "console",
+			Title:          "Game Name",
+			Platform:       "PS4",
+			Group:          "DUPLEX",
+			GroupCanonical: "DUPLEX",
+		},
+		"Some.Show.S4.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Some Show",
+			Season:         4,
+			Seasons:        []int{4},
+			IsSeasonPack:   true,
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Some.Game.Build.4523672.Cracked.MacOSX-GRP": &releaseparser.Release{
+			Type:           "pc",
+			Title:          "Some Game",
+			Platform:       "Mac",
+			Cracked:        true,
+			VersionInfo:    &releaseparser.VersionInfo{Build: 4523672},
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Adobe.Photoshop.2020.v21.2.1.x64.Multilingual-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "Adobe Photoshop 2020",
+			Version:        "v21.2.1",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 21, Minor: 2, Patch: 1},
+			Architecture:   "x64",
+			Multilingual:   true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Adobe.Photoshop.CC.2019.v20.0.4.x64.Portable-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "Adobe Photoshop CC 2019",
+			Version:        "v20.0.4",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 20, Minor: 0, Patch: 4},
+			Architecture:   "x64",
+			Portable:       true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Some.Tool.v2.3.MacOSX-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "Some Tool",
+			Version:        "v2.3",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 2, Minor: 3},
+			OS:             "Mac",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Windows.10.x64.Multilingual-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "Windows 10",
+			OS:             "Win",
+			Architecture:   "x64",
+			Multilingual:   true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Linux.Mint.20.x64-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "Linux Mint 20",
+			OS:             "Linux",
+			Architecture:   "x64",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"App.Pro.v5.Incl.Keygen-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "App Pro",
+			Version:        "v5",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 5, Minor: 0},
+			Keygen:         true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Some.App.v3.1.Retail.x86.Win-GRP": &releaseparser.Release{
+			Type:           "app",
+			Title:          "Some App",
+			Version:        "v3.1",
+			VersionInfo:    &releaseparser.VersionInfo{Major: 3, Minor: 1},
+			OS:             "Win",
+			Architecture:   "x86",
+			Retail:         true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Author.Name-Book.Title.2019.RETAIL.EPUB.eBook-GRP": &releaseparser.Release{
+			Type:           "ebook",
+			Title:          "Book Title",
+			Author:         "Author Name",
+			Year:           2019,
+			BookFormat:     "EPUB",
+			Retail:         true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Magazine.Name.July.2020.PDF": &releaseparser.Release{
+			Type:       "magazine",
+			Title:      "Magazine Name",
+			Year:       2020,
+			IssueDate:  time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+			BookFormat: "PDF",
+		},
+		"Some.Magazine.Issue.42.2019.PDF-GRP": &releaseparser.Release{
+			Type:           "magazine",
+			Title:          "Some Magazine",
+			Year:           2019,
+			Issue:          42,
+			BookFormat:     "PDF",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Comic.Title.001.2020.Digital.cbz": &releaseparser.Release{
+			Type:       "comic",
+			Title:      "Comic Title",
+			Year:       2020,
+			Issue:      1,
+			BookFormat: "CBZ",
+		},
+		"Comic.Title.#12.2019.cbr": &releaseparser.Release{
+			Type:       "comic",
+			Title:      "Comic Title",
+			Year:       2019,
+			Issue:      12,
+			BookFormat: "CBR",
+		},
+		"Author-Title.Unabridged.AUDIOBOOK-GRP": &releaseparser.Release{
+			Type:           "audiobook",
+			Title:          "Title",
+			Author:         "Author",
+			Unabridged:     true,
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Stephen.King-The.Stand.2011.MP3.AUDIOBOOK-GRP": &releaseparser.Release{
+			Type:           "audiobook",
+			Title:          "The Stand",
+			Author:         "Stephen King",
+			Year:           2011,
+			Audio:          "MP3",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Formula1.2020.Austrian.Grand.Prix.Race.1080p.WEB.x264-GRP": &releaseparser.Release{
+			Type:           "sports",
+			Title:          "Formula1",
+			League:         "Formula1",
+			Event:          "Austrian Grand Prix",
+			Session:        "Race",
+			Year:           2020,
+			Resolution:     "1080p",
+			Source:         ".WEB.x264",
+			SourceGroup:    "WEBDL",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Formula.One.2019.Round.08.French.Grand.Prix.Qualifying.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "sports",
+			Title:          "Formula One",
+			League:         "Formula1",
+			Event:          "French Grand Prix",
+			EventNumber:    8,
+			Session:        "Qualifying",
+			Year:           2019,
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"NFL.2020.09.13.Seahawks.vs.Falcons.720p": &releaseparser.Release{
+			Type:       "sports",
+			Title:      "NFL",
+			League:     "NFL",
+			Event:      "Seahawks vs Falcons",
+			Teams:      []string{"Seahawks", "Falcons"},
+			Year:       2020,
+			AirDate:    time.Date(2020, 9, 13, 0, 0, 0, 0, time.UTC),
+			Resolution: "720p",
+		},
+		"NBA.2020.Finals.Game.6.Lakers.vs.Heat.720p": &releaseparser.Release{
+			Type:       "sports",
+			Title:      "NBA",
+			League:     "NBA",
+			Event:      "Finals Game 6 Lakers vs Heat",
+			Teams:      []string{"Lakers", "Heat"},
+			Year:       2020,
+			Resolution: "720p",
+		},
+		"UFC.251.PPV.Main.Card": &releaseparser.Release{
+			Type:        "sports",
+			Title:       "UFC 251",
+			League:      "UFC",
+			EventNumber: 251,
+			Session:     "Main Card",
+		},
+		"UFC.Fight.Night.172.Prelims.720p.WEB.h264-GRP": &releaseparser.Release{
+			Type:           "sports",
+			Title:          "UFC Fight Night 172",
+			League:         "UFC",
+			Event:          "Fight Night 172",
+			Session:        "Prelims",
+			Resolution:     "720p",
+			Source:         ".WEB.h264",
+			SourceGroup:    "WEBDL",
+			Codec:          "h264",
+			CodecGroup:     "H264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"F1.2025.1080p.WEB-DL.DDP5.1.H.264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "F1",
+			Year:           2025,
+			Resolution:     "1080p",
+			Source:         "WEB-DL",
+			SourceGroup:    "WEBDL",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist.Live.At.Wembley.2019.1080p.MBluRay.x264-GRP": &releaseparser.Release{
+			Type:           "musicvideo",
+			Title:          "Live At Wembley",
+			Artist:         "Artist",
+			Performance:    "Live At Wembley",
+			Year:           2019,
+			Resolution:     "1080p",
+			Source:         "MBluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist-Song_Title-DVBS-x264-2020-GRP": &releaseparser.Release{
+			Type:           "musicvideo",
+			Title:          "Song Title",
+			Artist:         "Artist",
+			Performance:    "Song Title",
+			Year:           2020,
+			Source:         "DVBS",
+			SourceGroup:    "DVB",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Artist-Live_At_Rock_Am_Ring-DVDRip-XviD-2008-GRP": &releaseparser.Release{
+			Type:           "musicvideo",
+			Title:          "Live At Rock Am Ring",
+			Artist:         "Artist",
+			Performance:    "Live At Rock Am Ring",
+			Year:           2008,
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Some.Comedian.Live.At.The.Apollo.2019.1080p.WEB-DL.DD5.1.H264-GRP": &releaseparser.Release{
+			Type:           "musicvideo",
+			Title:          "Live At The Apollo",
+			Artist:         "Some Comedian",
+			Performance:    "Live At The Apollo",
+			Year:           2019,
+			Resolution:     "1080p",
+			Source:         "WEB-DL",
+			SourceGroup:    "WEBDL",
+			Codec:          "H264",
+			CodecGroup:     "H264",
+			Audio:          "DD5.1",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"We.Live.in.Time.2024.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "We Live in Time",
+			Year:           2024,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"2012.2009.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "2012",
+			Year:           2009,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+			Diagnostics:    []string{"ambiguous year: 2012, 2009 => 2009"},
+		},
+		"1917.2019.German.DL.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "1917",
+			Year:           2019,
+			Language:       "German",
+			DubType:        "DL",
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+			Diagnostics:    []string{"ambiguous year: 1917, 2019 => 2019"},
+		},
+		"Blade.Runner.2049.2017.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Blade Runner 2049",
+			Year:           2017,
+			Diagnostics:    []string{"ambiguous year: 2049, 2017 => 2017"},
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Blade.Runner.2049.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Blade Runner 2049",
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Wonder.Woman.1984.2020.1080p.WEB-DL.DD5.1.H264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Wonder Woman 1984",
+			Year:           2020,
+			Resolution:     "1080p",
+			Source:         "WEB-DL",
+			SourceGroup:    "WEBDL",
+			Codec:          "H264",
+			CodecGroup:     "H264",
+			Audio:          "DD5.1",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+			Diagnostics:    []string{"ambiguous year: 1984, 2020 => 2020"},
+		},
+		"Wonder Woman 1984 (2020) 1080p": &releaseparser.Release{
+			Type:        "movie",
+			Title:       "Wonder Woman 1984",
+			Year:        2020,
+			Resolution:  "1080p",
+			Diagnostics: []string{"ambiguous year: 1984, 2020 => 2020"},
+		},
+		"1917.German.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "1917",
+			Language:       "German",
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"The.Office.US.S01E01.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Office",
+			Country:        "US",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Shameless.UK.S01E01.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Shameless",
+			Country:        "GB",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Coming.to.the.US.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Coming to the US",
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Once.Upon.a.Time.in.the.US.2010.1080p-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Once Upon a Time in the US",
+			Year:           2010,
+			Resolution:     "1080p",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Doctor.Who.2005.S01E01.720p.HDTV.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Doctor Who",
+			Year:           2005,
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Movie.AKA.Other.Title.2010.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:            "movie",
+			Title:           "Movie",
+			AlternateTitles: []string{"Other Title"},
+			Year:            2010,
+			Resolution:      "1080p",
+			Source:          "BluRay",
+			SourceGroup:     "BLURAY",
+			Codec:           "x264",
+			CodecGroup:      "X264",
+			Group:           "GRP",
+			GroupCanonical:  "GRP",
+		},
+		"Us.2019.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Us",
+			Year:           2019,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Шерлок.S01E01.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Шерлок",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Мастер.и.Маргарита.Сезон.1.Серия.05.2005.DVDRip.XviD-GRP": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "Мастер и Маргарита",
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        5,
+			Episodes:       []int{5},
+			Year:           2005,
+			Source:         "DVDRip",
+			SourceGroup:    "DVD",
+			Codec:          "XviD",
+			CodecGroup:     "XVID",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"ТвинПиксS01.1080p-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "ТвинПиксS01",
+			Resolution:     "1080p",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Ame\u0301lie.2001.1080p.BluRay.x264-GRP": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Amélie",
+			Year:           2001,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"[Group] 進撃の巨人 - 01 [1080p][ABCD1234].mkv": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "進撃の巨人",
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "1080p",
+			Container:      "mkv",
+			CRC32:          "ABCD1234",
+			Group:          "Group",
+			GroupCanonical: "Group",
+		},
+		"【Erai-raws】 Shingeki no Kyojin - 01 【1080p】": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Shingeki no Kyojin",
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "1080p",
+			Group:          "Erai-raws",
+			GroupCanonical: "Erai-raws",
+		},
+		"[Ｓｕｂｓ] Ｔｉｔｌｅ － ０１ ［１０８０ｐ］": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Title",
+			Episode:        1,
+			Episodes:       []int{1},
+			Resolution:     "1080p",
+			Group:          "Subs",
+			GroupCanonical: "Subs",
+		},
+		"[SubsPlease] 「Kimetsu no Yaiba」 - 05 (1080p) [ABCDEF12].mkv": &releaseparser.Release{
+			Type:           "anime",
+			Title:          "Kimetsu no Yaiba",
+			Episode:        5,
+			Episodes:       []int{5},
+			Resolution:     "1080p",
+			Container:      "mkv",
+			CRC32:          "ABCDEF12",
+			Group:          "SubsPlease",
+			GroupCanonical: "SubsPlease",
+		},
+		"Movie Title (2010) [1080p] [BluRay] [5.1] [YTS.MX].mp4": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie Title",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Container:      "mp4",
+			Group:          "YTS.MX",
+			GroupCanonical: "YTS",
+		},
+		"Movie.Title.2010.1080p.BluRay.x264-[YTS.AM].mp4": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie Title",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Container:      "mp4",
+			Group:          "YTS.AM",
+			GroupCanonical: "YTS",
+		},
+		"Movie.Title.2010.1080p.BluRay.x265-RARBG.mp4": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie Title",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x265",
+			CodecGroup:     "H265",
+			Container:      "mp4",
+			Group:          "RARBG",
+			GroupCanonical: "RARBG",
+		},
+		"Movie.Title.2010.1080p.BluRay.x264-GRP [www.site.com]": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie Title",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "BluRay",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Website:        "www.site.com",
+			Group:          "GRP",
+			GroupCanonical: "GRP",
+		},
+		"Movie Title (2010) [1080p] [DTS-HD.MA].mkv": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Audio:      "DTS",
+			Container:  "mkv",
+		},
+		"Movie Title (2010) [1080p] [Dual.Audio].mkv": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Container:  "mkv",
+		},
+		"Movie Title (2010) [1080p] [x264.AAC].mkv": &releaseparser.Release{
+			Type:       "movie",
+			Title:      "Movie Title",
+			Year:       2010,
+			Resolution: "1080p",
+			Codec:      "x264",
+			CodecGroup: "X264",
+			Audio:      "AAC",
+			Container:  "mkv",
+		},
+		"Show - S01E02 - Episode Name [WEBDL-1080p].mkv": &releaseparser.Release{
+			Type:         "tvshow",
+			Title:        "Show",
+			Season:       1,
+			Seasons:      []int{1},
+			Episode:      2,
+			Episodes:     []int{2},
+			EpisodeTitle: "Episode Name",
+			Resolution:   "1080p",
+			Source:       "WEBDL",
+			SourceGroup:  "WEBDL",
+			Container:    "mkv",
+		},
+		"The Series Title! (2010) - S01E01 - Episode Title 1 [HDTV-720p Proper][AAC 2.0][x264]-RlsGrp.mkv": &releaseparser.Release{
+			Type:           "tvshow",
+			Title:          "The Series Title!",
+			Year:           2010,
+			Season:         1,
+			Seasons:        []int{1},
+			Episode:        1,
+			Episodes:       []int{1},
+			EpisodeTitle:   "Episode Title 1",
+			Resolution:     "720p",
+			Source:         "HDTV",
+			SourceGroup:    "HDTV",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Audio:          "AAC",
+			Proper:         true,
+			Revision:       1,
+			Container:      "mkv",
+			Group:          "RlsGrp",
+			GroupCanonical: "RlsGrp",
+		},
+		"Movie Title (2010) Bluray-1080p Proper.mkv": &releaseparser.Release{
+			Type:        "movie",
+			Title:       "Movie Title",
+			Year:        2010,
+			Resolution:  "1080p",
+			Source:      "Bluray",
+			SourceGroup: "BLURAY",
+			Proper:      true,
+			Revision:    1,
+			Container:   "mkv",
+		},
+		"Movie Title (2010) {imdb-tt1520211} [Bluray-1080p][DTS 5.1][x264]-EVO.mkv": &releaseparser.Release{
+			Type:           "movie",
+			Title:          "Movie Title",
+			Year:           2010,
+			Resolution:     "1080p",
+			Source:         "Bluray",
+			SourceGroup:    "BLURAY",
+			Codec:          "x264",
+			CodecGroup:     "X264",
+			Audio:          "DTS",
+			Container:      "mkv",
+			Group:          "EVO",
+			GroupCanonical: "EVO",
+		},
+		"a8f3c9e1b2d4.mkv": &releaseparser.Release{
+			Type:         "movie",
+			Container:    "mkv",
+			IsObfuscated: true,
+		},
+		"BBC.Documentary.mkv": &releaseparser.Release{
+			Type:      "movie",
+			Title:     "BBC Documentary",
+			Container: "mkv",
 		},
 	}
 
@@ -492,6 +2096,10 @@ func TestParse(t *testing.T) {
 		parsed := releaseparser.Parse(title)
 		t.Logf("Running tests for %s\n", title)
 
+		// the input is kept as given even if it was normalized for parsing
+		if title != parsed.Input {
+			t.Errorf("Input failed, got: %s, want: %s", parsed.Input, title)
+		}
 		if want.Title != parsed.Title {
 			t.Errorf("Title failed, got: %s, want: %s", parsed.Title, want.Title)
 		}
@@ -507,15 +2115,39 @@ func TestParse(t *testing.T) {
 		if want.SeasonEnd != parsed.SeasonEnd {
 			t.Errorf("SeasonEnd failed, got: %d, want: %d", parsed.SeasonEnd, want.SeasonEnd)
 		}
+		if !reflect.DeepEqual(want.Seasons, parsed.Seasons) {
+			t.Errorf("Seasons failed, got: %v, want: %v", parsed.Seasons, want.Seasons)
+		}
+		if want.IsSeasonPack != parsed.IsSeasonPack {
+			t.Errorf("IsSeasonPack failed, got: %t, want: %t", parsed.IsSeasonPack, want.IsSeasonPack)
+		}
+		if want.IsCompleteSeries != parsed.IsCompleteSeries {
+			t.Errorf("IsCompleteSeries failed, got: %t, want: %t", parsed.IsCompleteSeries, want.IsCompleteSeries)
+		}
+		if want.IsSpecial != parsed.IsSpecial {
+			t.Errorf("IsSpecial failed, got: %t, want: %t", parsed.IsSpecial, want.IsSpecial)
+		}
+		if want.IsObfuscated != parsed.IsObfuscated {
+			t.Errorf("IsObfuscated failed, got: %t, want: %t", parsed.IsObfuscated, want.IsObfuscated)
+		}
 		if want.Episode != parsed.Episode {
 			t.Errorf("Episode failed, got: %d, want: %d", parsed.Episode, want.Episode)
 		}
 		if want.EpisodeEnd != parsed.EpisodeEnd {
 			t.Errorf("EpisodeEnd failed, got: %d, want: %d", parsed.EpisodeEnd, want.EpisodeEnd)
 		}
+		if want.EpisodeTitle != parsed.EpisodeTitle {
+			t.Errorf("EpisodeTitle failed, got: %s, want: %s", parsed.EpisodeTitle, want.EpisodeTitle)
+		}
+		if !reflect.DeepEqual(want.Episodes, parsed.Episodes) {
+			t.Errorf("Episodes failed, got: %v, want: %v", parsed.Episodes, want.Episodes)
+		}
 		if want.Year != parsed.Year {
 			t.Errorf("Year failed, got: %d, want: %d", parsed.Year, want.Year)
 		}
+		if !want.AirDate.Equal(parsed.AirDate) {
+			t.Errorf("AirDate failed, got: %s, want: %s", parsed.AirDate, want.AirDate)
+		}
 		if want.Resolution != parsed.Resolution {
 			t.Errorf("Resolution failed, got: %s, want: %s", parsed.Resolution, want.Resolution)
 		}
@@ -540,6 +2172,111 @@ func TestParse(t *testing.T) {
 		if want.Group != parsed.Group {
 			t.Errorf("Group failed, got: %s, want: %s", parsed.Group, want.Group)
 		}
+		if want.GroupCanonical != parsed.GroupCanonical {
+			t.Errorf("GroupCanonical failed, got: %s, want: %s", parsed.GroupCanonical, want.GroupCanonical)
+		}
+		if want.Artist != parsed.Artist {
+			t.Errorf("Artist failed, got: %s, want: %s", parsed.Artist, want.Artist)
+		}
+		if want.Album != parsed.Album {
+			t.Errorf("Album failed, got: %s, want: %s", parsed.Album, want.Album)
+		}
+		if want.CatalogNumber != parsed.CatalogNumber {
+			t.Errorf("CatalogNumber failed, got: %s, want: %s", parsed.CatalogNumber, want.CatalogNumber)
+		}
+		if want.AudioFormat != parsed.AudioFormat {
+			t.Errorf("AudioFormat failed, got: %s, want: %s", parsed.AudioFormat, want.AudioFormat)
+		}
+		if want.Bitrate != parsed.Bitrate {
+			t.Errorf("Bitrate failed, got: %s, want: %s", parsed.Bitrate, want.Bitrate)
+		}
+		if want.Media != parsed.Media {
+			t.Errorf("Media failed, got: %s, want: %s", parsed.Media, want.Media)
+		}
+		if want.Platform != parsed.Platform {
+			t.Errorf("Platform failed, got: %s, want: %s", parsed.Platform, want.Platform)
+		}
+		if want.Update != parsed.Update {
+			t.Errorf("Update failed, got: %t, want: %t", parsed.Update, want.Update)
+		}
+		if want.DLC != parsed.DLC {
+			t.Errorf("DLC failed, got: %t, want: %t", parsed.DLC, want.DLC)
+		}
+		if want.Cracked != parsed.Cracked {
+			t.Errorf("Cracked failed, got: %t, want: %t", parsed.Cracked, want.Cracked)
+		}
+		if want.Eshop != parsed.Eshop {
+			t.Errorf("Eshop failed, got: %t, want: %t", parsed.Eshop, want.Eshop)
+		}
+		if want.GameFormat != parsed.GameFormat {
+			t.Errorf("GameFormat failed, got: %s, want: %s", parsed.GameFormat, want.GameFormat)
+		}
+		if want.LanguageCount != parsed.LanguageCount {
+			t.Errorf("LanguageCount failed, got: %d, want: %d", parsed.LanguageCount, want.LanguageCount)
+		}
+		if !reflect.DeepEqual(want.VersionInfo, parsed.VersionInfo) {
+			t.Errorf("VersionInfo failed, got: %v, want: %v", parsed.VersionInfo, want.VersionInfo)
+		}
+		if want.OS != parsed.OS {
+			t.Errorf("OS failed, got: %s, want: %s", parsed.OS, want.OS)
+		}
+		if want.Architecture != parsed.Architecture {
+			t.Errorf("Architecture failed, got: %s, want: %s", parsed.Architecture, want.Architecture)
+		}
+		if want.Multilingual != parsed.Multilingual {
+			t.Errorf("Multilingual failed, got: %t, want: %t", parsed.Multilingual, want.Multilingual)
+		}
+		if want.Keygen != parsed.Keygen {
+			t.Errorf("Keygen failed, got: %t, want: %t", parsed.Keygen, want.Keygen)
+		}
+		if want.Portable != parsed.Portable {
+			t.Errorf("Portable failed, got: %t, want: %t", parsed.Portable, want.Portable)
+		}
+		if want.Retail != parsed.Retail {
+			t.Errorf("Retail failed, got: %t, want: %t", parsed.Retail, want.Retail)
+		}
+		if want.Author != parsed.Author {
+			t.Errorf("Author failed, got: %s, want: %s", parsed.Author, want.Author)
+		}
+		if want.BookFormat != parsed.BookFormat {
+			t.Errorf("BookFormat failed, got: %s, want: %s", parsed.BookFormat, want.BookFormat)
+		}
+		if want.Issue != parsed.Issue {
+			t.Errorf("Issue failed, got: %d, want: %d", parsed.Issue, want.Issue)
+		}
+		if !want.IssueDate.Equal(parsed.IssueDate) {
+			t.Errorf("IssueDate failed, got: %s, want: %s", parsed.IssueDate, want.IssueDate)
+		}
+		if want.Unabridged != parsed.Unabridged {
+			t.Errorf("Unabridged failed, got: %t, want: %t", parsed.Unabridged, want.Unabridged)
+		}
+		if want.Country != parsed.Country {
+			t.Errorf("Country failed, got: %s, want: %s", parsed.Country, want.Country)
+		}
+		if !reflect.DeepEqual(want.AlternateTitles, parsed.AlternateTitles) {
+			t.Errorf("AlternateTitles failed, got: %v, want: %v", parsed.AlternateTitles, want.AlternateTitles)
+		}
+		if !reflect.DeepEqual(want.Diagnostics, parsed.Diagnostics) {
+			t.Errorf("Diagnostics failed, got: %v, want: %v", parsed.Diagnostics, want.Diagnostics)
+		}
+		if want.Performance != parsed.Performance {
+			t.Errorf("Performance failed, got: %s, want: %s", parsed.Performance, want.Performance)
+		}
+		if want.League != parsed.League {
+			t.Errorf("League failed, got: %s, want: %s", parsed.League, want.League)
+		}
+		if want.Event != parsed.Event {
+			t.Errorf("Event failed, got: %s, want: %s", parsed.Event, want.Event)
+		}
+		if want.EventNumber != parsed.EventNumber {
+			t.Errorf("EventNumber failed, got: %d, want: %d", parsed.EventNumber, want.EventNumber)
+		}
+		if !reflect.DeepEqual(want.Teams, parsed.Teams) {
+			t.Errorf("Teams failed, got: %v, want: %v", parsed.Teams, want.Teams)
+		}
+		if want.Session != parsed.Session {
+			t.Errorf("Session failed, got: %s, want: %s", parsed.Session, want.Session)
+		}
 		if want.Region != parsed.Region {
 			t.Errorf("Region failed, got: %s, want: %s", parsed.Region, want.Region)
 		}
@@ -558,15 +2295,30 @@ func TestParse(t *testing.T) {
 		if want.Proper != parsed.Proper {
 			t.Errorf("Proper failed, got: %t, want: %t", parsed.Proper, want.Proper)
 		}
+		if want.Revision != parsed.Revision {
+			t.Errorf("Revision failed, got: %d, want: %d", parsed.Revision, want.Revision)
+		}
 		if want.Repack != parsed.Repack {
 			t.Errorf("Repack failed, got: %t, want: %t", parsed.Repack, want.Repack)
 		}
+		if !reflect.DeepEqual(want.Flags, parsed.Flags) {
+			t.Errorf("Flags failed, got: %v, want: %v", parsed.Flags, want.Flags)
+		}
 		if want.Is3D != parsed.Is3D {
 			t.Errorf("Is3D failed, got: %t, want: %t", parsed.Is3D, want.Is3D)
 		}
 		if want.Uncut != parsed.Uncut {
 			t.Errorf("Uncut failed, got: %t, want: %t", parsed.Uncut, want.Uncut)
 		}
+		if want.Part != parsed.Part {
+			t.Errorf("Part failed, got: %d, want: %d", parsed.Part, want.Part)
+		}
+		if want.PartTotal != parsed.PartTotal {
+			t.Errorf("PartTotal failed, got: %d, want: %d", parsed.PartTotal, want.PartTotal)
+		}
+		if want.Disc != parsed.Disc {
+			t.Errorf("Disc failed, got: %d, want: %d", parsed.Disc, want.Disc)
+		}
 		if want.Container != parsed.Container {
 			t.Errorf("Container failed, got: %s, want: %s", parsed.Container, want.Container)
 		}
@@ -579,6 +2331,12 @@ func TestParse(t *testing.T) {
 		if want.Language != parsed.Language {
 			t.Errorf("Language failed, got: %s, want: %s", parsed.Language, want.Language)
 		}
+		if want.DubType != parsed.DubType {
+			t.Errorf("DubType failed, got: %s, want: %s", parsed.DubType, want.DubType)
+		}
+		if want.CRC32 != parsed.CRC32 {
+			t.Errorf("CRC32 failed, got: %s, want: %s", parsed.CRC32, want.CRC32)
+		}
 		if want.SBS != parsed.SBS {
 			t.Errorf("SBS failed, got: %s, want: %s", parsed.SBS, want.SBS)
 		}
@@ -588,3 +2346,116 @@ func TestParse(t *testing.T) {
 	}
 
 }
+
+func TestDubQuality(t *testing.T) {
+	mic := releaseparser.Parse("Dunkirk.2017.German.MD.DL.TS.x264-GRP")
+	line := releaseparser.Parse("Joker.2019.German.LD.DVDRip.XviD-PsO")
+	dl := releaseparser.Parse("Zombie.Bloody.Demons.UNCUT.GERMAN.1987.DL.1080p.BluRay.x264-GOREHOUNDS")
+	untagged := releaseparser.Parse("The.Boss.2016.UNCUT.720p.BRRip.x264.AAC-ETRG")
+
+	if mic.DubQuality() >= line.DubQuality() {
+		t.Errorf("MD should rank below LD, got: %d, %d", mic.DubQuality(), line.DubQuality())
+	}
+	if line.DubQuality() >= untagged.DubQuality() {
+		t.Errorf("LD should rank below untagged, got: %d, %d", line.DubQuality(), untagged.DubQuality())
+	}
+	if untagged.DubQuality() >= dl.DubQuality() {
+		t.Errorf("untagged should rank below DL, got: %d, %d", untagged.DubQuality(), dl.DubQuality())
+	}
+}
+
+func TestIsUpgradeOf(t *testing.T) {
+	original := releaseparser.Parse("Skins.S01E01.720p.HDTV.x264-TVS")
+	proper := releaseparser.Parse("Skins.S01E01.PROPER.720p.HDTV.x264-TVS")
+	realProper := releaseparser.Parse("Skins.S01E01.REAL.PROPER.720p.HDTV.x264-TVS")
+	dirfix := releaseparser.Parse("Skins.S01E01.DIRFIX.720p.HDTV.x264-TVS")
+
+	if !proper.IsUpgradeOf(original) {
+		t.Errorf("PROPER should upgrade the original release")
+	}
+	if !realProper.IsUpgradeOf(proper) {
+		t.Errorf("REAL.PROPER should upgrade PROPER")
+	}
+	if proper.IsUpgradeOf(realProper) {
+		t.Errorf("PROPER should not upgrade REAL.PROPER")
+	}
+	if dirfix.IsUpgradeOf(original) {
+		t.Errorf("DIRFIX should not upgrade the content")
+	}
+	if !dirfix.HasFlag("dirfix") {
+		t.Errorf("HasFlag failed for DIRFIX")
+	}
+}
+
+func TestSupersedes(t *testing.T) {
+	original := releaseparser.Parse("Skins.S01E01.720p.HDTV.x264-TVS")
+
+	tests := map[string]bool{
+		"Skins.S01E01.PROPER.720p.HDTV.x264-TVS":      true,
+		"Skins.S01E01.REPACK2.720p.HDTV.x264-TVS":     true,
+		"Skins.S01E01.PROPER.720p.HDTV.x264-OTHER":    false,
+		"Skins.S01E02.PROPER.720p.HDTV.x264-TVS":      false,
+		"Skins.S01E01.PROPER.1080p.HDTV.x264-TVS":     false,
+		"Skins.S01E01.DIRFIX.720p.HDTV.x264-TVS":      false,
+		"Skins.S01E01.720p.HDTV.x264-TVS":             false,
+		"Skins.S01E01.REAL.PROPER.720p.HDTV.x264-TVS": true,
+	}
+
+	for name, want := range tests {
+		if got := releaseparser.Supersedes(original, releaseparser.Parse(name)); got != want {
+			t.Errorf("Supersedes failed for %s, got: %t, want: %t", name, got, want)
+		}
+	}
+
+	// other languages, cuts, 3d or codecs are different content
+	movie := releaseparser.Parse("Movie.2010.720p.BluRay.x264-GRP")
+	tests = map[string]bool{
+		"Movie.2010.PROPER.720p.BluRay.x264-GRP":           true,
+		"Movie.2010.German.PROPER.720p.BluRay.x264-GRP":    false,
+		"Movie.2010.German.DL.PROPER.720p.BluRay.x264-GRP": false,
+		"Movie.2010.3D.PROPER.720p.BluRay.x264-GRP":        false,
+		"Movie.2010.EXTENDED.PROPER.720p.BluRay.x264-GRP":  false,
+		"Movie.2010.UNCUT.PROPER.720p.BluRay.x264-GRP":     false,
+		"Movie.2010.PROPER.720p.BluRay.x265-GRP":           false,
+	}
+
+	for name, want := range tests {
+		if got := releaseparser.Supersedes(movie, releaseparser.Parse(name)); got != want {
+			t.Errorf("Supersedes failed for %s, got: %t, want: %t", name, got, want)
+		}
+	}
+
+	rerip := releaseparser.Parse("Movie.2010.RERIP.720p.BluRay.x264-GRP")
+	if !releaseparser.Supersedes(rerip, releaseparser.Parse("Movie.2010.REAL.RERIP.720p.BluRay.x264-GRP")) {
+		t.Errorf("REAL.RERIP should supersede RERIP")
+	}
+
+	anime := releaseparser.Parse("[HorribleSubs] One Punch Man S2 - 05 [720p].mkv")
+	if !releaseparser.Supersedes(anime, releaseparser.Parse("[HorribleSubs] One Punch Man S2 - 05v2 [720p].mkv")) {
+		t.Errorf("v2 should supersede the first version")
+	}
+}
+
+func TestMarshalDates(t *testing.T) {
+	test := map[string]struct {
+		airDate   bool
+		issueDate bool
+	}{
+		"The.Matrix.1999.1080p.BluRay.x264-GRP":       {false, false},
+		"The.Daily.Show.2020.07.06.720p.WEB.h264-GRP": {true, false},
+		"Magazine.Name.July.2020.PDF":                 {false, true},
+	}
+
+	for name, want := range test {
+		b, err := json.Marshal(releaseparser.Parse(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Contains(string(b), `"air_date"`); got != want.airDate {
+			t.Errorf("air_date failed for %s, got: %t, want: %t", name, got, want.airDate)
+		}
+		if got := strings.Contains(string(b), `"issue_date"`); got != want.issueDate {
+			t.Errorf("issue_date failed for %s, got: %t, want: %t", name, got, want.issueDate)
+		}
+	}
+}
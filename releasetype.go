@@ -0,0 +1,148 @@
+package releaseparser
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TypeScore holds a release type together with the score of the evidence found for it
+type TypeScore struct {
+	Type  string  `json:"type"`
+	Score float64 `json:"score"`
+}
+
+// Classifier collects evidence for the type of a parsed release, the scores of all
+// registered classifiers are summed up and the type with the highest score wins
+type Classifier interface {
+	Classify(r *Release) []TypeScore
+}
+
+// ClassifierFunc is an adapter to use ordinary functions as Classifier
+type ClassifierFunc func(r *Release) []TypeScore
+
+// Classify calls f(r)
+func (f ClassifierFunc) Classify(r *Release) []TypeScore {
+	return f(r)
+}
+
+var (
+	// tokens that are evidence for a specific release type
+	typeTokens = []struct {
+		re    *regexp.Regexp
+		Type  string
+		Score float64
+	}{
+		{regexp.MustCompile(console), releaseTypeConsole, 6},
+		{regexp.MustCompile(`(?i)\b(?:Crack(?:ed|Fix)?|Update|DLC|Trainer)\b`), releaseTypePC, 3},
+		{regexp.MustCompile(`(?i)\b(?:x64|x86|ARM64|Multilingual|Incl[. ](?:Keygen|Patch|Serial)|Keygen|KeyMaker|Portable)\b`), releaseTypeApp, 3},
+		{regexp.MustCompile(appos), releaseTypeApp, 2},
+		{regexp.MustCompile(`(?i)\b(?:FLAC|MP3|[0-9]{3}kbps|CDDA|VINYL|WEB-FLAC)\b`), releaseTypeMusic, 3},
+		// media and year fields of dash separated music releases ex: Artist-Album-(CAT123)-WEB-2020-GRP
+		{regexp.MustCompile(`(?i)-(?:WEB|[0-9]*CD[MS]?|CDR|VINYL|VLS|SAT|DAB|FM)-(?:[^-]+-)*(?:19|20)[0-9]{2}-[^-]+$`), releaseTypeMusic, 4},
+		{regexp.MustCompile(mvsource), releaseTypeMusicVideo, 8},
+		// dash separated music videos ex: Artist-Song_Title-DVBS-x264-2020-GRP
+		{regexp.MustCompile(mvstructure), releaseTypeMusicVideo, 8},
+		{regexp.MustCompile(concert), releaseTypeMusicVideo, 6},
+		{regexp.MustCompile(`(?i)\b(?:EPUB|MOBI|AZW3|eBook)\b`), releaseTypeEbook, 3},
+		{regexp.MustCompile(`(?i)\bPDF\b`), releaseTypeEbook, 2},
+		{regexp.MustCompile(`(?i)\b(?:AUDIOBOOK|ABOOK|M4B|(?:UN)?ABRIDGED)\b`), releaseTypeAudiobook, 5},
+		{regexp.MustCompile(`(?i)\b(?:CBZ|CBR|Comics?)\b`), releaseTypeComic, 5},
+		{regexp.MustCompile(issuedate), releaseTypeMagazine, 3},
+		{regexp.MustCompile(`(?i)\b(?:Magazine|Issue[. ]?[0-9]+)\b`), releaseTypeMagazine, 3},
+	}
+
+	classifiers = []Classifier{
+		ClassifierFunc(classifyStructure),
+		ClassifierFunc(classifyGroup),
+		ClassifierFunc(classifyTokens),
+		ClassifierFunc(classifyLeague),
+	}
+	classifierMu sync.RWMutex
+)
+
+// RegisterClassifier adds a classifier which is consulted for the type of every parsed release
+func RegisterClassifier(c Classifier) {
+	classifierMu.Lock()
+	defer classifierMu.Unlock()
+
+	classifiers = append(classifiers, c)
+}
+
+// evidence from the already parsed parts of the release
+func classifyStructure(r *Release) []TypeScore {
+	// movie is the fallback if there is no evidence at all
+	scores := []TypeScore{{releaseTypeMovie, 1}}
+
+	if r.Season > 0 || r.Episode > 0 && r.Episode != parseInt(r.Codec) || !r.AirDate.IsZero() || r.IsSpecial || r.IsCompleteSeries {
+		scores = append(scores, TypeScore{releaseTypeTV, 10})
+	}
+	if r.Resolution != "" || r.Source != "" || r.Codec != "" {
+		scores = append(scores, TypeScore{releaseTypeMovie, 4})
+	}
+	// versions of pc games and applications look the same, only the os or architecture makes them an application
+	// ex: Game.v1.2.3-GRP, Some.Tool.v2.3.MacOSX-GRP
+	if r.Version != "" {
+		name := strings.ReplaceAll(r.Input, "_", ".")
+		if regexp.MustCompile(appos).MatchString(name) || regexp.MustCompile(architecture).MatchString(name) {
+			scores = append(scores, TypeScore{releaseTypeApp, 2})
+		} else {
+			scores = append(scores, TypeScore{releaseTypePC, 2})
+		}
+	}
+	return scores
+}
+
+// evidence from the type of the release group
+func classifyGroup(r *Release) []TypeScore {
+	if info, ok := LookupGroup(r.Group); ok && info.Type != "" {
+		return []TypeScore{{info.Type, 5}}
+	}
+	return nil
+}
+
+// evidence from type specific tokens
+func classifyTokens(r *Release) []TypeScore {
+	scores := []TypeScore{}
+	// underscores are word characters and would break the word boundaries of the token regexes
+	name := strings.ReplaceAll(r.Input, "_", ".")
+	for _, token := range typeTokens {
+		if token.re.MatchString(name) {
+			scores = append(scores, TypeScore{token.Type, token.Score})
+		}
+	}
+	// video releases use bit depths too ex: 1080p.BluRay.10bit.FLAC.x265
+	if r.Resolution == "" && r.Source == "" && r.Codec == "" && regexp.MustCompile(musicbitdepth).MatchString(name) {
+		scores = append(scores, TypeScore{releaseTypeMusic, 3})
+	}
+	return scores
+}
+
+// sums up the evidence of all classifiers, the result is sorted by score
+func classify(r *Release) []TypeScore {
+	// the classifiers are called without holding the lock, they may register other classifiers
+	classifierMu.RLock()
+	registered := classifiers
+	classifierMu.RUnlock()
+
+	sums := map[string]float64{}
+	order := []string{}
+	for _, c := range registered {
+		for _, score := range c.Classify(r) {
+			if _, ok := sums[score.Type]; !ok {
+				order = append(order, score.Type)
+			}
+			sums[score.Type] += score.Score
+		}
+	}
+
+	scores := make([]TypeScore, 0, len(order))
+	for _, t := range order {
+		scores = append(scores, TypeScore{t, sums[t]})
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+	return scores
+}
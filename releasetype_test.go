@@ -0,0 +1,62 @@
+package releaseparser_test
+
+import (
+	"testing"
+
+	"github.com/cytec/releaseparser"
+)
+
+func TestReleaseType(t *testing.T) {
+	test := map[string]string{
+		"Artist-Album-WEB-FLAC-2019-GRP":                                  "music",
+		"Author.Name-Book.Title.2019.RETAIL.EPUB.eBook-GRP":               "ebook",
+		"Artist-Song_Title-HDTV-x264-2019-GRP":                            "musicvideo",
+		"Artist.Live.In.Paris.2018.MDVDR-GRP":                             "musicvideo",
+		"Metallica.Live.At.Slane.Castle.2003.720p.BluRay":                 "musicvideo",
+		"Artist.Live.At.Wembley.2019.1080p.BluRay.x264-GRP":               "musicvideo",
+		"We.Live.in.Time.2024.1080p.BluRay.x264-GRP":                      "movie",
+		"Live.From.Baghdad.2002.DVDRip.XviD-GRP":                          "movie",
+		"Some.Tool.v2.3.MacOSX-GRP":                                       "app",
+		"Author-Title.Unabridged.AUDIOBOOK-GRP":                           "audiobook",
+		"Comic.Title.001.2020.Digital.cbz":                                "comic",
+		"Magazine.Name.July.2020.PDF":                                     "magazine",
+		"Game.v1.2.3-UNKNOWNGRP":                                          "pc",
+		"Game.Name.Update.v1.2-PLAZA":                                     "pc",
+		"NBA.2K20-CODEX":                                                  "pc",
+		"WWE.2K20.PS4-DUPLEX":                                             "console",
+		"NHL.20.PS4-DUPLEX":                                               "console",
+		"MotoGP.20.NSW-VENOM":                                             "console",
+		"F1.2020-CODEX":                                                   "pc",
+		"Game.Name.PS4-DUPLEX":                                            "console",
+		"Movie.Title.2004.German.1080p.BluRay.FLAC.x264-GRP":              "movie",
+		"Show.S01E01.Crack.720p.HDTV.x264-GRP":                            "tvshow",
+		"Parasite.2019.1080p.BluRay.10bit.FLAC.x265-GRP":                  "movie",
+		"Ghost.in.the.Shell.1995.1080p.BluRay.x265.10bit.FLAC.2.0-Tigole": "movie",
+		"Artist-Album-24BIT-96KHZ-FLAC-2019-GRP":                          "music",
+	}
+
+	for name, want := range test {
+		r := releaseparser.Parse(name)
+		if r.Type != want {
+			t.Errorf("Type failed for %s, got: %s, want: %s (%v)", name, r.Type, want, r.TypeScores)
+		}
+	}
+}
+
+func TestRegisterClassifier(t *testing.T) {
+	t.Cleanup(releaseparser.SaveClassifiers())
+	releaseparser.RegisterClassifier(releaseparser.ClassifierFunc(func(r *releaseparser.Release) []releaseparser.TypeScore {
+		if r.Group == "MYBOOKS" {
+			return []releaseparser.TypeScore{{Type: "ebook", Score: 20}}
+		}
+		return nil
+	}))
+
+	r := releaseparser.Parse("Some.Title.2019.1080p.BluRay.x264-MYBOOKS")
+	if r.Type != "ebook" {
+		t.Errorf("Type failed, got: %s, want: ebook", r.Type)
+	}
+	if len(r.TypeScores) < 2 || r.TypeScores[1].Type != "movie" {
+		t.Errorf("TypeScores failed, got: %v", r.TypeScores)
+	}
+}
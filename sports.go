@@ -0,0 +1,216 @@
+package releaseparser
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// LeagueInfo holds the canonical name and known aliases of a sports league or promotion
+type LeagueInfo struct {
+	Name    string   `json:"name"`              // canonical name of the league ex: Formula1
+	Aliases []string `json:"aliases,omitempty"` // alternative spellings of the league ex: F1, Formula.One
+}
+
+var (
+	session     = `(?i)\b(?:(?P<practice>(?:Free[. ])?Practice(?:[. ][1-3])?|FP[1-3])|(?P<qualifying>(?:Sprint[. ])?Qualifying|Quali)|(?P<sprint>Sprint(?:[. ]Race)?)|(?P<race>Race)|(?P<warmup>Warm[. ]?Up)|(?P<prelims>(?:Early[. ])?Prelims)|(?P<maincard>Main[. ]Card))\b`
+	eventnumber = `(?i)^[. ](?:Round[. ]?|Rd[. ]?|Week[. ]?)?([0-9]{1,4})\b`
+	sportsyear  = `^[. ]((?:19|20)[0-9]{2})\b`
+	sportstags  = `(?i)\b(?:PPV|Pre[. ]?Show|Post[. ]?Show|Full[. ]Event)\b`
+	teams       = `(?i) (?:vs?|versus|@) `
+	// event words and numbers in front of the home team ex: Finals Game 6 Lakers vs Heat
+	teamprefix = `(?i)^.*\b(?:Grand Prix|Fight Night|Championships?|(?:Semi ?)?Finals?|Playoffs?|Super ?Bowl|Matchday|Game|Match|Week|Round|Rd|[0-9]+) `
+	// events and pairings which are evidence for sports ex: Grand.Prix, Seahawks.vs.Falcons
+	sportsevent = `(?i)\b(?:Grand[. ]Prix|Fight[. ]Night|Championships?|(?:Semi[. ]?)?Finals?|Playoffs?|Super[. ]?Bowl|Matchday|Highlights|vs?|versus)\b`
+
+	// normalized session names
+	sessionMap = map[string]string{
+		"PRACTICE":   "Practice",
+		"QUALIFYING": "Qualifying",
+		"SPRINT":     "Sprint",
+		"RACE":       "Race",
+		"WARMUP":     "Warm Up",
+		"PRELIMS":    "Prelims",
+		"MAINCARD":   "Main Card",
+	}
+
+	// holds all known leagues, keyed by the lowercase name and aliases without separators
+	leagueRegistry = map[string]*LeagueInfo{}
+	leagueMu       sync.RWMutex
+
+	defaultLeagues = []LeagueInfo{
+		{Name: "Formula1", Aliases: []string{"F1", "Formula.1", "Formula.One"}},
+		{Name: "Formula2", Aliases: []string{"F2", "Formula.2"}},
+		{Name: "MotoGP"},
+		{Name: "IndyCar"},
+		{Name: "NASCAR", Aliases: []string{"NASCAR.Cup.Series"}},
+		{Name: "WRC"},
+		{Name: "NFL"},
+		{Name: "NBA"},
+		{Name: "NHL"},
+		{Name: "MLB"},
+		{Name: "MLS"},
+		{Name: "NCAAF"},
+		{Name: "NCAAB"},
+		{Name: "UFC"},
+		{Name: "Bellator"},
+		{Name: "WWE"},
+		{Name: "AEW"},
+		{Name: "EPL", Aliases: []string{"Premier.League", "English.Premier.League"}},
+		{Name: "Bundesliga"},
+		{Name: "LaLiga", Aliases: []string{"La.Liga"}},
+		{Name: "Serie.A"},
+		{Name: "UEFA.Champions.League", Aliases: []string{"UCL", "Champions.League"}},
+	}
+)
+
+func init() {
+	for _, l := range defaultLeagues {
+		RegisterLeague(l)
+	}
+}
+
+// normalizes league names for the registry ex: Formula.One => formulaone
+func leagueKey(name string) string {
+	return strings.ToLower(regexp.MustCompile(`[. _-]`).ReplaceAllString(name, ""))
+}
+
+// RegisterLeague adds a league to the registry or replaces an existing one with the same name or alias
+func RegisterLeague(l LeagueInfo) {
+	leagueMu.Lock()
+	defer leagueMu.Unlock()
+
+	info := l
+	leagueRegistry[leagueKey(info.Name)] = &info
+	for _, alias := range info.Aliases {
+		leagueRegistry[leagueKey(alias)] = &info
+	}
+}
+
+// LoadLeagues registers all leagues of a JSON array of LeagueInfo objects ex:
+// [{"name": "Formula1", "aliases": ["F1", "Formula.One"]}]
+func LoadLeagues(r io.Reader) error {
+	leagues := []LeagueInfo{}
+	if err := json.NewDecoder(r).Decode(&leagues); err != nil {
+		return err
+	}
+	for _, l := range leagues {
+		RegisterLeague(l)
+	}
+	return nil
+}
+
+// LoadLeaguesFile registers all leagues of the given JSON file, see LoadLeagues
+func LoadLeaguesFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return LoadLeagues(f)
+}
+
+// LookupLeague returns the registered league info for the given league name or alias
+func LookupLeague(name string) (LeagueInfo, bool) {
+	leagueMu.RLock()
+	defer leagueMu.RUnlock()
+
+	info, ok := leagueRegistry[leagueKey(name)]
+	if !ok {
+		return LeagueInfo{}, false
+	}
+	return *info, true
+}
+
+// finds a registered league at the start of the release name, the longest match wins
+// returns the league and the matched text ex: Formula.One.2020.Race => Formula1, Formula.One
+func findLeague(s string) (LeagueInfo, string, bool) {
+	words := regexp.MustCompile(`[^. _]+`).FindAllStringIndex(s, 4)
+	for i := len(words) - 1; i >= 0; i-- {
+		prefix := s[:words[i][1]]
+		if info, ok := LookupLeague(prefix); ok {
+			return info, prefix, true
+		}
+	}
+	return LeagueInfo{}, "", false
+}
+
+// evidence from a known league at the start of the release followed by a date, round, session or event,
+// games named after leagues have platform or group evidence and are left to the other classifiers
+// ex: Formula1.2020.Austrian.Grand.Prix.Race but not F1.2020-CODEX or NHL.20.PS4-DUPLEX
+func classifyLeague(r *Release) []TypeScore {
+	name := strings.ReplaceAll(r.Input, "_", ".")
+	_, prefix, ok := findLeague(name)
+	if !ok || regexp.MustCompile(console).MatchString(name) || regexp.MustCompile(platform).MatchString(name) {
+		return nil
+	}
+	if info, ok := LookupGroup(r.Group); ok && info.Type != "" && info.Type != releaseTypeSports {
+		return nil
+	}
+
+	rest := name[len(prefix):]
+	if m := regexp.MustCompile(sportsyear).FindString(rest); m != "" {
+		rest = rest[len(m):]
+	}
+	if !r.AirDate.IsZero() || regexp.MustCompile(eventnumber).MatchString(rest) || regexp.MustCompile(session).MatchString(rest) ||
+		regexp.MustCompile(sportstags).MatchString(rest) || regexp.MustCompile(sportsevent).MatchString(rest) {
+		return []TypeScore{{releaseTypeSports, 12}}
+	}
+	return nil
+}
+
+// parses league, event, teams and session of sports releases
+// ex: Formula1.2020.Austrian.Grand.Prix.Race.1080p.WEB.x264-GRP, NFL.2020.09.13.Seahawks.vs.Falcons.720p
+func (r *Release) parseSports(s string) {
+	info, prefix, ok := findLeague(s)
+	if !ok {
+		return
+	}
+	r.League = info.Name
+
+	// date or season year, then the event number directly follow the league
+	pos := len(prefix)
+	if p, ok := r.parts["airdate"]; ok && !r.AirDate.IsZero() && strings.HasPrefix(s[pos:], "."+p) {
+		pos += len(p) + 1
+	} else if m := regexp.MustCompile(sportsyear).FindStringSubmatch(s[pos:]); m != nil {
+		r.Year = parseInt(m[1])
+		pos += len(m[0])
+	}
+	if m := regexp.MustCompile(eventnumber).FindStringSubmatch(s[pos:]); m != nil {
+		r.EventNumber = parseInt(m[1])
+		pos += len(m[0])
+	}
+
+	re := regexp.MustCompile(session)
+	if m := re.FindString(s[pos:]); m != "" {
+		r.Session = sessionMap[getMatchedGroupName(re, m)]
+		r.part("session", r.Input, m)
+	}
+	if m := regexp.MustCompile(sportstags).FindString(s[pos:]); m != "" {
+		r.part("sportstags", r.Input, m)
+	}
+
+	// the event is everything between the league info and the first tag
+	// languages are part of event names ex: French.Grand.Prix
+	end := len(s)
+	for name, p := range r.parts {
+		if index := strings.Index(s[pos:], p); name != "language" && index >= 0 && pos+index < end {
+			end = pos + index
+		}
+	}
+	r.Event = cleanTitle(s[pos:end])
+	if p, ok := r.parts["language"]; ok && strings.Contains(s[pos:end], p) {
+		r.Language = ""
+	}
+
+	if t := regexp.MustCompile(teams).Split(r.Event, -1); len(t) == 2 {
+		t[0] = regexp.MustCompile(teamprefix).ReplaceAllString(t[0], "")
+		r.Teams = t
+	}
+
+	// the title ends at the session and tags too ex: UFC.251.PPV.Main.Card => UFC 251
+	r.setTitle()
+}
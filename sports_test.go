@@ -0,0 +1,52 @@
+package releaseparser_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cytec/releaseparser"
+)
+
+func TestLookupLeague(t *testing.T) {
+	test := map[string]string{
+		"F1":               "Formula1",
+		"Formula.One":      "Formula1",
+		"formula_1":        "Formula1",
+		"Premier.League":   "EPL",
+		"Champions League": "UEFA.Champions.League",
+	}
+
+	for name, want := range test {
+		info, ok := releaseparser.LookupLeague(name)
+		if !ok {
+			t.Errorf("LookupLeague failed for %s, league not found", name)
+			continue
+		}
+		if info.Name != want {
+			t.Errorf("Name failed for %s, got: %s, want: %s", name, info.Name, want)
+		}
+	}
+
+	if _, ok := releaseparser.LookupLeague("Some.Show"); ok {
+		t.Errorf("LookupLeague should not find unknown leagues")
+	}
+}
+
+func TestLoadLeagues(t *testing.T) {
+	t.Cleanup(releaseparser.SaveLeagues())
+	leagues := `[{"name": "PDC", "aliases": ["PDC.Darts"]}]`
+	if err := releaseparser.LoadLeagues(strings.NewReader(leagues)); err != nil {
+		t.Fatalf("LoadLeagues failed: %s", err)
+	}
+
+	r := releaseparser.Parse("PDC.Darts.2019.World.Championship.Final.720p.HDTV.x264-GRP")
+	if r.Type != "sports" {
+		t.Errorf("Type failed, got: %s, want: sports", r.Type)
+	}
+	if r.League != "PDC" {
+		t.Errorf("League failed, got: %s, want: PDC", r.League)
+	}
+	if r.Event != "World Championship Final" {
+		t.Errorf("Event failed, got: %s, want: World Championship Final", r.Event)
+	}
+}
@@ -0,0 +1,140 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// dotted acronyms which are uppercased by TitleCase ex: S.H.I.E.L.D, s.w.a.t.
+	acronym = `\b(?:[A-Za-z]\.){2,}(?:[A-Za-z]\b)?`
+	// leading articles which are moved to the end of sort titles
+	article = `(?i)^(The|A|An|Der|Die|Das) (.+)$`
+	// country of regional versions directly after the title ex: The.Office.US, Shameless.UK, only split when
+	// a year or episode follows because titles end with these words too ex: Coming.to.the.US.1080p
+	country = `^(.+?) \(?(US|UK|GB|AU|NZ|CA|IE)\)?$`
+	// separator of alternate titles ex: Movie.AKA.Other.Title
+	aka = `(?i) (?:aka|a\.k\.a\.) `
+
+	// ISO 3166 codes of country tokens which differ from the code
+	countryMap = map[string]string{
+		"UK": "GB",
+	}
+
+	// words which stay lowercase in title case unless they are the first or last word
+	smallWords = map[string]bool{
+		"a": true, "an": true, "the": true, "and": true, "or": true, "of": true, "in": true, "on": true,
+		"at": true, "to": true, "for": true, "by": true, "from": true, "with": true, "vs": true,
+	}
+
+	// transliterations of umlauts and letters without a decomposition, other diacritics are removed by searchString
+	transliterator = strings.NewReplacer(
+		"ä", "ae", "ö", "oe", "ü", "ue", "ß", "ss", "æ", "ae", "œ", "oe", "ø", "o", "þ", "th", "ð", "d",
+		"đ", "d", "ı", "i", "ł", "l",
+		"&", " and ", "'", "", "’", "", "`", "", "´", "", ".", "",
+	)
+)
+
+// TitleCase capitalizes the words of a title, acronyms and words with mixed case are preserved
+// ex: marvels agents of s.h.i.e.l.d => Marvels Agents of S.H.I.E.L.D., CSI: miami => CSI: Miami
+func TitleCase(s string) string {
+	words := strings.Split(s, " ")
+	for i, word := range words {
+		if word != "" && regexp.MustCompile(acronym).FindString(word) == word {
+			// dotted acronyms are uppercased and keep their last dot
+			words[i] = strings.ToUpper(strings.TrimSuffix(word, ".")) + "."
+			continue
+		}
+		lower := strings.ToLower(word)
+		if word != lower && word != upperFirst(lower) {
+			// acronyms and names like iZombie or McGregor
+			continue
+		}
+		if smallWords[lower] && i > 0 && i < len(words)-1 {
+			words[i] = lower
+		} else {
+			words[i] = upperFirst(lower)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// uppercases the first letter of a word, unlike strings.Title letters after apostrophes stay lowercase
+func upperFirst(word string) string {
+	if word == "" {
+		return word
+	}
+	c, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(c)) + word[size:]
+}
+
+// splits alternate titles and the country of regional versions from the title
+func (r *Release) splitTitle() {
+	titles := regexp.MustCompile(aka).Split(r.Title, -1)
+	r.Title = titles[0]
+	for _, t := range titles[1:] {
+		if t = strings.Trim(t, " "); t != "" {
+			r.AlternateTitles = append(r.AlternateTitles, t)
+		}
+	}
+
+	// countries after articles and prepositions are part of the title ex: Once.Upon.a.Time.in.the.US.2010
+	if m := regexp.MustCompile(country).FindStringSubmatch(r.Title); m != nil && r.followedBy("year", "season", "episode", "multiep", "airdate") &&
+		!smallWords[strings.ToLower(m[1][strings.LastIndex(m[1], " ")+1:])] {
+		r.Title = m[1]
+		r.Country = m[2]
+		if code, ok := countryMap[m[2]]; ok {
+			r.Country = code
+		}
+	}
+}
+
+// true if one of the given parts directly follows the title
+func (r *Release) followedBy(names ...string) bool {
+	for _, name := range names {
+		if clean, ok := r.parts[name]; ok && clean != "" && strings.Index(r.Input, clean) == r.end {
+			return true
+		}
+	}
+	return false
+}
+
+// SortTitle returns the title with a leading article moved to the end ex: The Walking Dead => Walking Dead, The
+func (r *Release) SortTitle() string {
+	return regexp.MustCompile(article).ReplaceAllString(r.Title, "$2, $1")
+}
+
+// SearchTitle returns the lowercased title without diacritics, apostrophes and special characters
+// ex: Grey's Anatomy => greys anatomy, Tom & Jerry => tom and jerry, Die Känguru-Chroniken => die kaenguru chroniken
+func (r *Release) SearchTitle() string {
+	return searchString(r.Title)
+}
+
+// Slug returns the search title and year joined by hyphens ex: the-walking-dead, blade-runner-2049-2017
+func (r *Release) Slug() string {
+	slug := strings.Replace(r.SearchTitle(), " ", "-", -1)
+	if r.Year > 0 {
+		slug += "-" + strconv.Itoa(r.Year)
+	}
+	return slug
+}
+
+// normalizes a string for comparisons, see SearchTitle
+func searchString(s string) string {
+	s = transliterator.Replace(norm.NFC.String(strings.ToLower(s)))
+	// decomposed letters lose their marks ex: é => e, ș => s
+	s = strings.Map(func(c rune) rune {
+		if unicode.Is(unicode.Mn, c) {
+			return -1
+		}
+		return c
+	}, norm.NFD.String(s))
+	return strings.Join(strings.FieldsFunc(s, func(c rune) bool {
+		return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+	}), " ")
+}
@@ -0,0 +1,59 @@
+package releaseparser_test
+
+import (
+	"testing"
+
+	"github.com/cytec/releaseparser"
+)
+
+func TestTitleCase(t *testing.T) {
+	test := map[string]string{
+		"the walking dead":               "The Walking Dead",
+		"marvels agents of S.H.I.E.L.D.": "Marvels Agents of S.H.I.E.L.D.",
+		"marvels agents of s.h.i.e.l.d":  "Marvels Agents of S.H.I.E.L.D.",
+		"grey's anatomy":                 "Grey's Anatomy",
+		"CSI: miami":                     "CSI: Miami",
+		"iZombie":                        "iZombie",
+		"The Lord Of The Rings":          "The Lord of the Rings",
+		"what we do in the shadows":      "What We Do in the Shadows",
+	}
+
+	for title, want := range test {
+		if got := releaseparser.TitleCase(title); got != want {
+			t.Errorf("TitleCase failed for %s, got: %s, want: %s", title, got, want)
+		}
+	}
+}
+
+func TestTitleHelpers(t *testing.T) {
+	test := map[string]struct {
+		sort   string
+		search string
+		slug   string
+	}{
+		"The.Walking.Dead.S01E01.720p.HDTV.x264-GRP":               {"Walking Dead, The", "the walking dead", "the-walking-dead"},
+		"Die.Kaenguru-Chroniken.2020.German.1080p.BluRay.x264-GRP": {"Kaenguru Chroniken, Die", "die kaenguru chroniken", "die-kaenguru-chroniken-2020"},
+		"Die.Känguru-Chroniken.2020.German.1080p.BluRay.x264-GRP":  {"Känguru Chroniken, Die", "die kaenguru chroniken", "die-kaenguru-chroniken-2020"},
+		"Greys.Anatomy.S01E01.720p.HDTV.x264-GRP":                  {"Greys Anatomy", "greys anatomy", "greys-anatomy"},
+		"Grey's Anatomy S01E01 720p HDTV x264-GRP":                 {"Grey's Anatomy", "greys anatomy", "greys-anatomy"},
+		"Tom & Jerry 2021 1080p WEB-DL DD5.1 H264-GRP":             {"Tom & Jerry", "tom and jerry", "tom-and-jerry-2021"},
+		"Amélie.2001.1080p.BluRay.x264-GRP":                        {"Amélie", "amelie", "amelie-2001"},
+		"Ștefan.2020.1080p.WEB-DL.x264-GRP":                        {"Ștefan", "stefan", "stefan-2020"},
+		"Việt.Nam.2018.1080p.WEB-DL.x264-GRP":                      {"Việt Nam", "viet nam", "viet-nam-2018"},
+		"Ŕoman.2019.1080p.WEB-DL.x264-GRP":                         {"Ŕoman", "roman", "roman-2019"},
+		"Шерлок.S01E01.1080p.BluRay.x264-GRP":                      {"Шерлок", "шерлок", "шерлок"},
+	}
+
+	for name, want := range test {
+		r := releaseparser.Parse(name)
+		if got := r.SortTitle(); got != want.sort {
+			t.Errorf("SortTitle failed for %s, got: %s, want: %s", name, got, want.sort)
+		}
+		if got := r.SearchTitle(); got != want.search {
+			t.Errorf("SearchTitle failed for %s, got: %s, want: %s", name, got, want.search)
+		}
+		if got := r.Slug(); got != want.slug {
+			t.Errorf("Slug failed for %s, got: %s, want: %s", name, got, want.slug)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package releaseparser
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// cjk brackets and quotes ex: 【Group】, 「Title」
+	cjkReplacer = strings.NewReplacer("【", "[", "】", "]", "〔", "[", "〕", "]", "「", "", "」", "", "『", "", "』", "")
+)
+
+// normalizes release names, full-width characters are converted to ascii, cjk brackets to ascii brackets
+// and letters with combining marks are composed (NFC) ex: ［１０８０ｐ］ => [1080p], 【Group】 => [Group]
+func normalize(s string) string {
+	s = strings.Map(func(c rune) rune {
+		switch {
+		case c >= '！' && c <= '～':
+			return c - 0xFEE0
+		case c == '　':
+			return ' '
+		}
+		return c
+	}, s)
+	return norm.NFC.String(cjkReplacer.Replace(s))
+}
+
+// finds the first match which isn't part of a longer word, go regexes only know ascii word boundaries
+// so tokens glued to non-latin letters would match otherwise ex: S01 in ТвинПиксS01
+func findToken(re *regexp.Regexp, s string) []int {
+	for _, loc := range re.FindAllStringIndex(s, -1) {
+		first, _ := utf8.DecodeRuneInString(s[loc[0]:])
+		last, _ := utf8.DecodeLastRuneInString(s[:loc[1]])
+		before, _ := utf8.DecodeLastRuneInString(s[:loc[0]])
+		after, _ := utf8.DecodeRuneInString(s[loc[1]:])
+		if isWordRune(first) && isUnicodeLetter(before) || isWordRune(last) && isUnicodeLetter(after) {
+			continue
+		}
+		return loc
+	}
+	return nil
+}
+
+func isWordRune(c rune) bool {
+	return unicode.IsLetter(c) || unicode.IsDigit(c)
+}
+
+// true for letters and digits outside of ascii which go regexes don't treat as word characters
+func isUnicodeLetter(c rune) bool {
+	return c > unicode.MaxASCII && c != utf8.RuneError && isWordRune(c)
+}